@@ -0,0 +1,268 @@
+// Command mockgen generates the testify based mocks of package upapimock from
+// the API and endpoint interfaces declared in package upapi.
+//
+//	go run ./internal/mockgen -src pkg/upapi -out pkg/upapimock/mocks_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const upapiPath = "github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+
+func main() {
+	src := flag.String("src", "pkg/upapi", "directory of package upapi")
+	out := flag.String("out", "pkg/upapimock/mocks_gen.go", "output file")
+	flag.Parse()
+
+	code, err := Generate(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Generate returns the mocks source for the package in dir.
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []*iface
+	imports := map[string]bool{
+		"github.com/stretchr/testify/mock": true,
+		upapiPath:                          true,
+	}
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		fileImports := make(map[string]string)
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			alias := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				alias = imp.Name.Name
+			}
+			fileImports[alias] = path
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok || ts.TypeParams != nil || !mocked(ts.Name.Name) {
+					continue
+				}
+				i, err := newIface(fset, ts.Name.Name, it, fileImports, imports)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", ts.Name.Name, err)
+				}
+				ifaces = append(ifaces, i)
+			}
+		}
+	}
+	sort.Slice(ifaces, func(i, j int) bool {
+		return ifaces[i].name < ifaces[j].name
+	})
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/mockgen; DO NOT EDIT.\n\npackage upapimock\n\nimport (\n")
+	paths := make([]string, 0, len(imports))
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if si, sj := stdlib(paths[i]), stdlib(paths[j]); si != sj {
+			return si
+		}
+		return paths[i] < paths[j]
+	})
+	for i, p := range paths {
+		if i > 0 && stdlib(p) != stdlib(paths[i-1]) {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\t%q\n", p)
+	}
+	b.WriteString(")\n")
+	for _, i := range ifaces {
+		i.write(&b)
+	}
+	return format.Source(b.Bytes())
+}
+
+func stdlib(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+// mocked reports whether the interface named name gets a mock: the API
+// itself and every endpoint.
+func mocked(name string) bool {
+	return name == "API" || strings.HasSuffix(name, "Endpoint")
+}
+
+type iface struct {
+	name    string
+	methods []method
+}
+
+type method struct {
+	name    string
+	params  []string
+	results []string
+}
+
+func newIface(fset *token.FileSet, name string, it *ast.InterfaceType, fileImports map[string]string, imports map[string]bool) (*iface, error) {
+	i := &iface{name: name}
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+		m := method{name: field.Names[0].Name}
+		var err error
+		if m.params, err = fieldTypes(fset, ft.Params, fileImports, imports); err != nil {
+			return nil, err
+		}
+		if m.results, err = fieldTypes(fset, ft.Results, fileImports, imports); err != nil {
+			return nil, err
+		}
+		i.methods = append(i.methods, m)
+	}
+	return i, nil
+}
+
+func fieldTypes(fset *token.FileSet, fields *ast.FieldList, fileImports map[string]string, imports map[string]bool) ([]string, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	var out []string
+	for _, f := range fields.List {
+		if _, ok := f.Type.(*ast.Ellipsis); ok {
+			return nil, fmt.Errorf("variadic parameters are not supported")
+		}
+		qualify(f.Type, fileImports, imports)
+		var b bytes.Buffer
+		if err := printer.Fprint(&b, fset, f.Type); err != nil {
+			return nil, err
+		}
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for j := 0; j < n; j++ {
+			out = append(out, b.String())
+		}
+	}
+	return out, nil
+}
+
+// qualify rewrites the type expression e in place so that it refers to
+// exported upapi identifiers through the package name, and records the
+// imports it needs.
+func qualify(e ast.Expr, fileImports map[string]string, imports map[string]bool) {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			t.Name = "upapi." + t.Name
+		}
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok {
+			if path, ok := fileImports[x.Name]; ok {
+				imports[path] = true
+			}
+		}
+	case *ast.StarExpr:
+		qualify(t.X, fileImports, imports)
+	case *ast.ArrayType:
+		qualify(t.Elt, fileImports, imports)
+	case *ast.MapType:
+		qualify(t.Key, fileImports, imports)
+		qualify(t.Value, fileImports, imports)
+	case *ast.ChanType:
+		qualify(t.Value, fileImports, imports)
+	case *ast.IndexExpr:
+		qualify(t.X, fileImports, imports)
+		qualify(t.Index, fileImports, imports)
+	case *ast.IndexListExpr:
+		qualify(t.X, fileImports, imports)
+		for _, idx := range t.Indices {
+			qualify(idx, fileImports, imports)
+		}
+	case *ast.FuncType:
+		for _, fl := range []*ast.FieldList{t.Params, t.Results} {
+			if fl == nil {
+				continue
+			}
+			for _, f := range fl.List {
+				qualify(f.Type, fileImports, imports)
+			}
+		}
+	}
+}
+
+func (i *iface) write(b *bytes.Buffer) {
+	fmt.Fprintf(b, "\n// %s is a mock of upapi.%s.\n", i.name, i.name)
+	fmt.Fprintf(b, "type %s struct {\n\tmock.Mock\n}\n\n", i.name)
+	fmt.Fprintf(b, "var _ upapi.%s = (*%s)(nil)\n\n", i.name, i.name)
+	fmt.Fprintf(b, "// New%s returns a new %s mock asserting its expectations when the test ends.\n", i.name, i.name)
+	fmt.Fprintf(b, "func New%s(t TestingT) *%s {\n", i.name, i.name)
+	fmt.Fprintf(b, "\tm := new(%s)\n\tm.Test(t)\n\tt.Cleanup(func() { m.AssertExpectations(t) })\n\treturn m\n}\n", i.name)
+	for _, m := range i.methods {
+		m.write(b, i.name)
+	}
+}
+
+func (m method) write(b *bytes.Buffer, recv string) {
+	args := make([]string, len(m.params))
+	params := make([]string, len(m.params))
+	for j, p := range m.params {
+		args[j] = fmt.Sprintf("a%d", j)
+		params[j] = fmt.Sprintf("a%d %s", j, p)
+	}
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	fmt.Fprintf(b, "\nfunc (m *%s) %s(%s) %s {\n", recv, m.name, strings.Join(params, ", "), results)
+	if len(m.results) == 0 {
+		fmt.Fprintf(b, "\tm.Called(%s)\n}\n", strings.Join(args, ", "))
+		return
+	}
+	fmt.Fprintf(b, "\tret := m.Called(%s)\n", strings.Join(args, ", "))
+	sig := fmt.Sprintf("func(%s)", strings.Join(m.params, ", "))
+	rets := make([]string, len(m.results))
+	for j, r := range m.results {
+		rets[j] = fmt.Sprintf("r%d", j)
+		fmt.Fprintf(b, "\tvar r%d %s\n", j, r)
+		fmt.Fprintf(b, "\tif f, ok := ret.Get(%d).(%s %s); ok {\n", j, sig, r)
+		fmt.Fprintf(b, "\t\tr%d = f(%s)\n", j, strings.Join(args, ", "))
+		fmt.Fprintf(b, "\t} else if ret.Get(%d) != nil {\n", j)
+		fmt.Fprintf(b, "\t\tr%d = ret.Get(%d).(%s)\n\t}\n", j, j, r)
+	}
+	fmt.Fprintf(b, "\treturn %s\n}\n", strings.Join(rets, ", "))
+}
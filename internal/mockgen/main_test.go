@@ -0,0 +1,16 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMocksUpToDate(t *testing.T) {
+	want, err := Generate("../../pkg/upapi")
+	require.NoError(t, err)
+	got, err := os.ReadFile("../../pkg/upapimock/mocks_gen.go")
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got), "upapimock is stale, run go generate ./pkg/upapimock")
+}
@@ -16,13 +16,17 @@ var errNoToken = errors.New("token is required")
 
 // cmd represents the base command when called without any subcommands
 var (
-	api upapi.API
+	api      upapi.API
+	resolver *upapi.Resolver
+	// account holds the credentials api was created with.
+	account profile
 
 	cmdArgs = struct {
-		Color  bool   `flag:"color"  usage:"Enable color for json output"`
-		Output string `flag:"output" short:"o" usage:"Output format (json|spew)"`
-		Token  string `flag:"token"  usage:"Uptime.com API token"`
-		Trace  bool   `flag:"trace"  usage:"Trace HTTP requests"`
+		Color   bool   `flag:"color"  usage:"Enable color for json output"`
+		Output  string `flag:"output" short:"o" usage:"Output format (json|spew)"`
+		Profile string `flag:"profile" usage:"Use the token and subaccount of a profile of the profiles file"`
+		Token   string `flag:"token"  usage:"Uptime.com API token"`
+		Trace   bool   `flag:"trace"  usage:"Trace HTTP requests"`
 	}{
 		Color:  true,
 		Output: "json",
@@ -34,23 +38,41 @@ var (
 		Long:          "", // TODO: add long description
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) (err error) {
-			token := viper.GetString("token")
-			if token == "" {
-				return errNoToken
+			p := profile{Token: viper.GetString("token")}
+			if cmdArgs.Profile != "" {
+				if p, err = readProfile(cmdArgs.Profile); err != nil {
+					return err
+				}
 			}
-			opts := []upapi.Option{
-				upapi.WithToken(viper.GetString("token")),
+			if p.Token == "" {
+				return errNoToken
 			}
-			if cmdArgs.Trace {
-				opts = append(opts, upapi.WithTrace(os.Stderr))
+			account = p
+			api, err = newAPI(p)
+			if err != nil {
+				return err
 			}
-			opts = append(opts, upapi.WithRetry(10, time.Second*30, os.Stderr))
-			api, err = upapi.New(opts...)
-			return err
+			resolver = upapi.NewResolver(api)
+			return nil
 		},
 	}
 )
 
+// newAPI returns a client for the account of p.
+func newAPI(p profile) (upapi.API, error) {
+	opts := []upapi.Option{
+		upapi.WithToken(p.Token),
+	}
+	if p.Subaccount != 0 {
+		opts = append(opts, upapi.WithSubaccount(p.Subaccount))
+	}
+	if cmdArgs.Trace {
+		opts = append(opts, upapi.WithTrace(os.Stderr))
+	}
+	opts = append(opts, upapi.WithRetry(10, time.Second*30, os.Stderr))
+	return upapi.New(opts...)
+}
+
 func init() {
 	err := Bind(cmd.PersistentFlags(), &cmdArgs)
 	if err != nil {
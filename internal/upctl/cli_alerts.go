@@ -21,8 +21,6 @@ func init() {
 
 var (
 	alertsListFlags = upapi.AlertListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "-created_at",
 	}
 	alertsListCmd = &cobra.Command{
@@ -45,11 +43,7 @@ func init() {
 }
 
 func alertsList(ctx context.Context) ([]upapi.AlertItem, error) {
-	result, err := api.Alerts().List(ctx, alertsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Alerts(), alertsListFlags, alertsListFlags.Page)
 }
 
 var alertsGetCmd = &cobra.Command{
@@ -0,0 +1,81 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var (
+	applyFlags = struct {
+		Filename []string `flag:"filename" short:"f" usage:"Manifest file or directory, read recursively"`
+		Prune    bool     `flag:"prune" usage:"Delete the resources without a manifest, of the kinds the manifests describe"`
+		DryRun   bool     `flag:"dry-run" usage:"Only print the plan"`
+		Yes      bool     `flag:"yes" short:"y" usage:"Apply without asking for confirmation"`
+	}{}
+	applyCmd = &cobra.Command{
+		Use:   "apply -f <path>",
+		Short: "Bring the account in line with YAML or JSON manifests",
+		Long: `Manifests describe a resource each, by kind and name:
+
+  kind: StatusPage
+  name: Public
+  spec:
+    slug: public
+    components:
+      - name: Website
+        service: www.example.com
+
+Spec fields are named as in the API, and other resources are referred to by
+name. The supported kinds are Tag, User, Contact, Integration, Check,
+Dashboard, SLAReport, ScheduledReport and StatusPage. Nested resources are
+listed in the spec of their parent: service_variables of a check,
+reporting_groups of an SLA report, and the components, metrics, incidents,
+subscribers, subscription_domain_allow_list, subscription_domain_block_list
+and users of a status page. upctl export writes the manifests of an existing
+account.
+
+The changes needed for the account to match the manifests are printed, then
+made once confirmed, in dependency order. Resources without a manifest are
+left alone unless --prune is given.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return applyRun(cmd.Context())
+		},
+	}
+)
+
+func init() {
+	err := Bind(applyCmd.Flags(), &applyFlags)
+	if err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(applyCmd)
+}
+
+func applyRun(ctx context.Context) error {
+	if len(applyFlags.Filename) == 0 {
+		return fmt.Errorf("no manifests given, use -f")
+	}
+	manifests, err := upapi.LoadManifests(applyFlags.Filename...)
+	if err != nil {
+		return err
+	}
+	plan, err := upapi.PlanManifests(ctx, api, manifests, upapi.PlanOptions{Prune: applyFlags.Prune})
+	if err != nil {
+		return err
+	}
+	if err := output(plan, nil); err != nil {
+		return err
+	}
+	if plan.Empty() || applyFlags.DryRun {
+		return nil
+	}
+	if !applyFlags.Yes && !confirm(fmt.Sprintf("Make these %d changes?", len(plan.Changes))) {
+		return fmt.Errorf("aborted")
+	}
+	return upapi.ApplyPlan(ctx, api, plan)
+}
@@ -0,0 +1,56 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Audit the account configuration",
+	Args:  cobra.NoArgs,
+}
+
+func init() {
+	cmd.AddCommand(auditCmd)
+}
+
+var (
+	auditRefsFlags = struct {
+		NoFail bool `flag:"no-fail" usage:"Exit with status 0 even when issues are found"`
+	}{}
+	auditRefsCmd = &cobra.Command{
+		Use:   "refs",
+		Short: "Report dangling references and unused tags",
+		Long: "Load the whole account and report references which no longer resolve, such as checks alerting deleted " +
+			"contacts or status page components following deleted checks, along with unused tags. The report is " +
+			"printed as JSON and the command exits with a non-zero status when it is not empty.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := auditRefs(cmd.Context())
+			if err := output(report, err); err != nil {
+				return err
+			}
+			if !report.OK() && !auditRefsFlags.NoFail {
+				return fmt.Errorf("%d reference issues found", len(report.Issues))
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	err := Bind(auditRefsCmd.Flags(), &auditRefsFlags)
+	if err != nil {
+		panic(err)
+	}
+	auditCmd.AddCommand(auditRefsCmd)
+}
+
+func auditRefs(ctx context.Context) (*upapi.RefReport, error) {
+	return upapi.AuditRefs(ctx, api)
+}
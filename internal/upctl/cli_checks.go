@@ -2,7 +2,6 @@ package upctl
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -23,8 +22,6 @@ func init() {
 
 var (
 	checksListFlags = upapi.CheckListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	checksListCmd = &cobra.Command{
@@ -33,7 +30,7 @@ var (
 		Short:   "List checks",
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return output(checksList(cmd.Context()))
+			return output(checksList(cmd.Context(), cmd.Flags().Changed("is-paused")))
 		},
 	}
 )
@@ -46,12 +43,12 @@ func init() {
 	checksCmd.AddCommand(checksListCmd)
 }
 
-func checksList(ctx context.Context) ([]upapi.Check, error) {
-	result, err := api.Checks().List(ctx, checksListFlags)
-	if err != nil {
-		return nil, err
+func checksList(ctx context.Context, byPaused bool) ([]upapi.Check, error) {
+	if checksListFlags.Page > 0 || byPaused {
+		return listPages(ctx, api.Checks(), checksListFlags, checksListFlags.Page)
 	}
-	return result.Items, nil
+	// Without --is-paused list paused checks too: the filter is always sent.
+	return upapi.ListAllChecks(ctx, api.Checks(), checksListFlags)
 }
 
 var checksGetCmd = &cobra.Command{
@@ -69,7 +66,7 @@ func init() {
 }
 
 func checksGet(ctx context.Context, pkstr string) (*upapi.Check, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceCheck, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +208,11 @@ func checksUpdateSubcommand(name string, flags any, fn func(context.Context, int
 		Short: "Update " + name + " check",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pk, err := strconv.Atoi(args[0])
+			pk, err := resolvePK(cmd.Context(), upapi.ResourceCheck, args[0])
 			if err != nil {
 				return err
 			}
-			return output(fn(cmd.Context(), pk))
+			return output(fn(cmd.Context(), int(pk)))
 		},
 	}
 	err := Bind(cmd.Flags(), flags)
@@ -353,7 +350,7 @@ func init() {
 }
 
 func checksDelete(ctx context.Context, pkstr string) (*upapi.Check, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceCheck, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -385,7 +382,7 @@ func init() {
 }
 
 func checksStats(ctx context.Context, pkstr string) ([]upapi.CheckStats, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceCheck, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -18,11 +18,8 @@ func init() {
 }
 
 var (
-	checksCloudStatusGroupsListFlags = upapi.CloudStatusGroupListOptions{
-		Page:     1,
-		PageSize: 100,
-	}
-	checksCloudStatusGroupsListCmd = &cobra.Command{
+	checksCloudStatusGroupsListFlags = upapi.CloudStatusGroupListOptions{}
+	checksCloudStatusGroupsListCmd   = &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "List cloud status provider groups",
@@ -42,11 +39,7 @@ func init() {
 }
 
 func checksCloudStatusGroupsList(ctx context.Context) ([]upapi.CloudStatusGroupListItem, error) {
-	result, err := api.Checks().ListCloudStatusGroups(ctx, checksCloudStatusGroupsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, listerFunc[upapi.CloudStatusGroupListItem, upapi.CloudStatusGroupListOptions](api.Checks().ListCloudStatusGroups), checksCloudStatusGroupsListFlags, checksCloudStatusGroupsListFlags.Page)
 }
 
 var checksCloudStatusServicesCmd = &cobra.Command{
@@ -59,11 +52,8 @@ func init() {
 }
 
 var (
-	checksCloudStatusServicesListFlags = upapi.CloudStatusServiceListOptions{
-		Page:     1,
-		PageSize: 100,
-	}
-	checksCloudStatusServicesListCmd = &cobra.Command{
+	checksCloudStatusServicesListFlags = upapi.CloudStatusServiceListOptions{}
+	checksCloudStatusServicesListCmd   = &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "List cloud status services (filter via --group=<id|name substring>)",
@@ -83,9 +73,5 @@ func init() {
 }
 
 func checksCloudStatusServicesList(ctx context.Context) ([]upapi.CloudStatusService, error) {
-	result, err := api.Checks().ListCloudStatusServices(ctx, checksCloudStatusServicesListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, listerFunc[upapi.CloudStatusService, upapi.CloudStatusServiceListOptions](api.Checks().ListCloudStatusServices), checksCloudStatusServicesListFlags, checksCloudStatusServicesListFlags.Page)
 }
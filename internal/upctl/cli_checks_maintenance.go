@@ -0,0 +1,216 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var checksMaintenanceCmd = &cobra.Command{
+	Use:     "maintenance",
+	Aliases: []string{"maint"},
+	Short:   "Manage check maintenance schedules",
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	checksCmd.AddCommand(checksMaintenanceCmd)
+}
+
+// checksMaintenanceReport is the output of the maintenance commands.
+type checksMaintenanceReport struct {
+	upapi.CheckMaintenance
+	InMaintenance bool                       `json:"in_maintenance"`
+	Upcoming      []upapi.MaintenanceWindow  `json:"upcoming"`
+	Overlaps      []upapi.MaintenanceOverlap `json:"overlaps,omitempty"`
+}
+
+var checksMaintenanceShowFlags = struct {
+	Count    int64  `flag:"count" short:"n" usage:"Number of upcoming windows to show"`
+	Timezone string `flag:"timezone" usage:"Timezone the schedule is expressed in, the local one by default"`
+}{
+	Count: 5,
+}
+
+var checksMaintenanceShowCmd = &cobra.Command{
+	Use:   "show <pk>",
+	Short: "Show the maintenance schedule of a check and its upcoming windows",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return output(checksMaintenanceShow(cmd.Context(), args[0]))
+	},
+}
+
+func init() {
+	err := Bind(checksMaintenanceShowCmd.Flags(), &checksMaintenanceShowFlags)
+	if err != nil {
+		panic(err)
+	}
+	checksMaintenanceCmd.AddCommand(checksMaintenanceShowCmd)
+}
+
+func checksMaintenanceShow(ctx context.Context, pkstr string) (*checksMaintenanceReport, error) {
+	check, err := checksGet(ctx, pkstr)
+	if err != nil {
+		return nil, err
+	}
+	return checksMaintenanceReportOf(check)
+}
+
+func checksMaintenanceReportOf(check *upapi.Check) (*checksMaintenanceReport, error) {
+	report := &checksMaintenanceReport{Upcoming: []upapi.MaintenanceWindow{}}
+	if check.Maintenance == nil {
+		return report, nil
+	}
+	report.CheckMaintenance = *check.Maintenance
+	loc := time.Local
+	if tz := checksMaintenanceShowFlags.Timezone; tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return nil, err
+		}
+	}
+	cal, err := upapi.NewMaintenanceCalendar(*check.Maintenance, loc)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	report.InMaintenance = cal.InMaintenance(now)
+	report.Upcoming = append(report.Upcoming, cal.NextWindows(now, int(checksMaintenanceShowFlags.Count))...)
+	if n := len(report.Upcoming); n > 0 {
+		report.Overlaps = cal.Overlaps(cal, now, report.Upcoming[n-1].End)
+	}
+	return report, nil
+}
+
+var (
+	checksMaintenanceSetFlags = struct {
+		State   string   `flag:"state" usage:"Maintenance state (ACTIVE|SCHEDULED|SUSPENDED), SCHEDULED when schedules are given"`
+		Pause   bool     `flag:"pause-on-scheduled-maintenance" usage:"Pause the check during scheduled maintenance"`
+		Daily   []string `flag:"daily" usage:"Daily window, as HH:MM-HH:MM"`
+		Weekly  []string `flag:"weekly" usage:"Weekly window, as WEEKDAYS@HH:MM-HH:MM with weekdays from 0 (Monday) to 6, e.g. 0,4@23:00-01:00"`
+		Monthly []string `flag:"monthly" usage:"Monthly window, as DAY[-DAY]@HH:MM-HH:MM, e.g. 1@02:00-04:00"`
+		Once    []string `flag:"once" usage:"One-off window, as START/END dates, e.g. 2026-03-02T00:30:00/2026-03-02T02:00:00"`
+	}{}
+	checksMaintenanceSetCmd = &cobra.Command{
+		Use:   "set <pk>",
+		Short: "Replace the maintenance schedule of a check",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var pause *bool
+			if cmd.Flags().Changed("pause-on-scheduled-maintenance") {
+				pause = &checksMaintenanceSetFlags.Pause
+			}
+			return output(checksMaintenanceSet(cmd.Context(), args[0], pause))
+		},
+	}
+)
+
+func init() {
+	err := Bind(checksMaintenanceSetCmd.Flags(), &checksMaintenanceSetFlags)
+	if err != nil {
+		panic(err)
+	}
+	checksMaintenanceSetCmd.Flags().StringVar(&checksMaintenanceShowFlags.Timezone, "timezone", "", "Timezone to show upcoming windows in, the local one by default")
+	checksMaintenanceCmd.AddCommand(checksMaintenanceSetCmd)
+}
+
+func checksMaintenanceSet(ctx context.Context, pkstr string, pause *bool) (*checksMaintenanceReport, error) {
+	pk, err := resolvePK(ctx, upapi.ResourceCheck, pkstr)
+	if err != nil {
+		return nil, err
+	}
+	m, err := parseMaintenanceFlags()
+	if err != nil {
+		return nil, err
+	}
+	m.PauseOnScheduledMaintenance = pause
+	if m.State == "" && pause == nil {
+		return nil, fmt.Errorf("nothing to set, give a state, a schedule or --pause-on-scheduled-maintenance")
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	check, err := api.Checks().UpdateMaintenance(ctx, upapi.PrimaryKey(pk), m)
+	if err != nil {
+		return nil, err
+	}
+	return checksMaintenanceReportOf(check)
+}
+
+func parseMaintenanceFlags() (upapi.CheckMaintenance, error) {
+	f := checksMaintenanceSetFlags
+	m := upapi.CheckMaintenance{State: strings.ToUpper(f.State)}
+	for _, s := range f.Daily {
+		from, to, err := parseTimeRange(s)
+		if err != nil {
+			return m, err
+		}
+		m.Schedule = append(m.Schedule, upapi.CheckMaintenanceSchedule{Type: "DAILY", FromTime: from, ToTime: to})
+	}
+	for _, s := range f.Weekly {
+		days, times, ok := strings.Cut(s, "@")
+		if !ok {
+			return m, fmt.Errorf("invalid weekly window %q", s)
+		}
+		from, to, err := parseTimeRange(times)
+		if err != nil {
+			return m, err
+		}
+		sched := upapi.CheckMaintenanceSchedule{Type: "WEEKLY", FromTime: from, ToTime: to}
+		for _, d := range strings.Split(days, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(d))
+			if err != nil {
+				return m, fmt.Errorf("invalid weekday %q", d)
+			}
+			sched.Weekdays = append(sched.Weekdays, n)
+		}
+		m.Schedule = append(m.Schedule, sched)
+	}
+	for _, s := range f.Monthly {
+		days, times, ok := strings.Cut(s, "@")
+		if !ok {
+			return m, fmt.Errorf("invalid monthly window %q", s)
+		}
+		from, to, err := parseTimeRange(times)
+		if err != nil {
+			return m, err
+		}
+		sched := upapi.CheckMaintenanceSchedule{Type: "MONTHLY", FromTime: from, ToTime: to}
+		first, last, isRange := strings.Cut(days, "-")
+		if sched.MonthdayFrom, err = strconv.Atoi(first); err != nil {
+			return m, fmt.Errorf("invalid monthday %q", first)
+		}
+		if !isRange {
+			sched.Monthday, sched.MonthdayFrom = sched.MonthdayFrom, 0
+		} else if sched.MonthdayTo, err = strconv.Atoi(last); err != nil {
+			return m, fmt.Errorf("invalid monthday %q", last)
+		}
+		m.Schedule = append(m.Schedule, sched)
+	}
+	for _, s := range f.Once {
+		start, end, ok := strings.Cut(s, "/")
+		if !ok {
+			return m, fmt.Errorf("invalid one-off window %q", s)
+		}
+		m.Schedule = append(m.Schedule, upapi.CheckMaintenanceSchedule{Type: "ONCE", OnceStartDate: start, OnceEndDate: end})
+	}
+	if m.State == "" && len(m.Schedule) > 0 {
+		m.State = "SCHEDULED"
+	}
+	return m, nil
+}
+
+func parseTimeRange(s string) (from, to string, err error) {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return "", "", fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", s)
+	}
+	return from, to, nil
+}
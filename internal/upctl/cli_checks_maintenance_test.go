@@ -0,0 +1,40 @@
+package upctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestParseMaintenanceFlags(t *testing.T) {
+	checksMaintenanceSetFlags.Daily = []string{"02:00-03:00"}
+	checksMaintenanceSetFlags.Weekly = []string{"0,4@23:00-01:00"}
+	checksMaintenanceSetFlags.Monthly = []string{"1@02:00-04:00", "30-31@22:00-06:00"}
+	checksMaintenanceSetFlags.Once = []string{"2026-03-02T00:30:00/2026-03-02T02:00:00"}
+	defer func() {
+		checksMaintenanceSetFlags.Daily = nil
+		checksMaintenanceSetFlags.Weekly = nil
+		checksMaintenanceSetFlags.Monthly = nil
+		checksMaintenanceSetFlags.Once = nil
+	}()
+
+	m, err := parseMaintenanceFlags()
+	require.NoError(t, err)
+	require.Equal(t, upapi.CheckMaintenance{
+		State: "SCHEDULED",
+		Schedule: []upapi.CheckMaintenanceSchedule{
+			{Type: "DAILY", FromTime: "02:00", ToTime: "03:00"},
+			{Type: "WEEKLY", FromTime: "23:00", ToTime: "01:00", Weekdays: []int{0, 4}},
+			{Type: "MONTHLY", FromTime: "02:00", ToTime: "04:00", Monthday: 1},
+			{Type: "MONTHLY", FromTime: "22:00", ToTime: "06:00", MonthdayFrom: 30, MonthdayTo: 31},
+			{Type: "ONCE", OnceStartDate: "2026-03-02T00:30:00", OnceEndDate: "2026-03-02T02:00:00"},
+		},
+	}, m)
+	require.NoError(t, m.Validate())
+
+	checksMaintenanceSetFlags.Weekly = []string{"0,4 23:00-01:00"}
+	_, err = parseMaintenanceFlags()
+	require.EqualError(t, err, `invalid weekly window "0,4 23:00-01:00"`)
+}
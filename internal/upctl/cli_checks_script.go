@@ -0,0 +1,211 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var checksScriptCmd = &cobra.Command{
+	Use:   "script",
+	Short: "Author API and transaction check scripts as YAML",
+	Long: `Scripts are written as a YAML list of steps:
+
+  - step_def: C_POST
+    values:
+      url: https://example.com/login
+      data: '{"user": "{{USER}}"}'
+  - step_def: C_SET_VARIABLE_FROM_JSON
+    values: {variable_name: token, json_path: $.token}
+
+and given to "checks create api" or "checks create transaction" with --script-file.`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	checksCmd.AddCommand(checksScriptCmd)
+}
+
+var checksScriptRenderCmd = &cobra.Command{
+	Use:   "render <file>",
+	Short: "Print the msp_script value of a YAML script",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := checksScriptRender(args[0])
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), s)
+		return err
+	},
+}
+
+func init() {
+	checksScriptCmd.AddCommand(checksScriptRenderCmd)
+}
+
+func checksScriptRender(path string) (string, error) {
+	script, err := readScriptFile(path)
+	if err != nil {
+		return "", err
+	}
+	return upapi.MarshalScript(script)
+}
+
+var checksScriptShowCmd = &cobra.Command{
+	Use:   "show <pk>",
+	Short: "Print the script of a check as YAML",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		script, err := checksScriptShow(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent(2)
+		if err := enc.Encode(script); err != nil {
+			return err
+		}
+		return enc.Close()
+	},
+}
+
+func init() {
+	checksScriptCmd.AddCommand(checksScriptShowCmd)
+}
+
+func checksScriptShow(ctx context.Context, pkstr string) (upapi.Script, error) {
+	check, err := checksGet(ctx, pkstr)
+	if err != nil {
+		return nil, err
+	}
+	if check.Script == "" {
+		return nil, fmt.Errorf("check %d has no script", check.PK)
+	}
+	return upapi.ParseScript(check.Script)
+}
+
+var (
+	checksScriptValidateFlags = struct {
+		ServiceVariables []string `flag:"service-variable" usage:"Name of a service variable the script may use"`
+	}{}
+	checksScriptValidateCmd = &cobra.Command{
+		Use:   "validate <file>",
+		Short: "Check the order of the steps of a YAML script and the variables they use",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return checksScriptValidate(args[0])
+		},
+	}
+)
+
+func init() {
+	err := Bind(checksScriptValidateCmd.Flags(), &checksScriptValidateFlags)
+	if err != nil {
+		panic(err)
+	}
+	checksScriptCmd.AddCommand(checksScriptValidateCmd)
+}
+
+func checksScriptValidate(path string) error {
+	script, err := readScriptFile(path)
+	if err != nil {
+		return err
+	}
+	return script.Validate(checksScriptValidateFlags.ServiceVariables...)
+}
+
+func init() {
+	for name, script := range map[string]*string{
+		"api":         &checksCreateAPIFlags.Script,
+		"transaction": &checksCreateTransactionFlags.Script,
+	} {
+		withScriptFile(subcommand(checksCreateCmd, name), script)
+	}
+	for name, script := range map[string]*string{
+		"api":         &updateCreateAPIFlags.Script,
+		"transaction": &updateCreateTransactionFlags.Script,
+	} {
+		withScriptFile(subcommand(checksUpdateCmd, name), script)
+	}
+}
+
+// withScriptFile adds a --script-file flag to c which sets script from a
+// YAML file before c runs, once it passes Script.Validate with the service
+// variables given with --service-variable.
+func withScriptFile(c *cobra.Command, script *string) {
+	var path string
+	var serviceVariables []string
+	c.Flags().StringVar(&path, "script-file", "", "Read the script from a YAML file (see 'upctl checks script')")
+	c.Flags().StringSliceVar(&serviceVariables, "service-variable", nil, "Name of a service variable the script file may use")
+	c.PreRunE = func(*cobra.Command, []string) error {
+		if path == "" {
+			return nil
+		}
+		s, err := readScriptFile(path)
+		if err != nil {
+			return err
+		}
+		if err := s.Validate(serviceVariables...); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		*script, err = upapi.MarshalScript(s)
+		return err
+	}
+}
+
+func subcommand(parent *cobra.Command, name string) *cobra.Command {
+	for _, c := range parent.Commands() {
+		if c.Name() == name {
+			return c
+		}
+	}
+	panic("no such subcommand: " + parent.Name() + " " + name)
+}
+
+// readScriptFile loads a YAML script. Numbers and booleans are turned into
+// strings, nested ones included, the way the API stores every step value.
+func readScriptFile(path string) (upapi.Script, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var script upapi.Script
+	if err := yaml.Unmarshal(b, &script); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, step := range script {
+		for k, v := range step.Values {
+			step.Values[k] = stringifyScriptValue(v)
+		}
+	}
+	return script, nil
+}
+
+func stringifyScriptValue(v any) any {
+	switch v := v.(type) {
+	case int:
+		return strconv.Itoa(v)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]any:
+		for k, item := range v {
+			v[k] = stringifyScriptValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = stringifyScriptValue(item)
+		}
+	}
+	return v
+}
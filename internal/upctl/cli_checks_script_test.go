@@ -0,0 +1,45 @@
+package upctl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksScriptRender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.yaml")
+	err := os.WriteFile(path, []byte(`
+- step_def: C_GET
+  values:
+    url: https://example.com/health
+    headers:
+      Authorization: Bearer {{TOKEN}}
+      X-Retries: 3
+    params: [1, true]
+- step_def: V_HTTP_STATUS_CODE_SHOULD_BE
+  values: {status_code: 200}
+`), 0o600)
+	require.NoError(t, err)
+
+	s, err := checksScriptRender(path)
+	require.NoError(t, err)
+	require.Equal(t, `[{"step_def":"C_GET","values":{"headers":{"Authorization":"Bearer {{TOKEN}}","X-Retries":"3"},"params":["1","true"],"url":"https://example.com/health"}},{"step_def":"V_HTTP_STATUS_CODE_SHOULD_BE","values":{"status_code":"200"}}]`, s)
+
+	checksScriptValidateFlags.ServiceVariables = []string{"TOKEN"}
+	require.NoError(t, checksScriptValidate(path))
+	checksScriptValidateFlags.ServiceVariables = nil
+	require.Error(t, checksScriptValidate(path))
+
+	var script string
+	c := &cobra.Command{Use: "api"}
+	withScriptFile(c, &script)
+	require.NoError(t, c.Flags().Parse([]string{"--script-file", path}))
+	require.ErrorContains(t, c.PreRunE(c, nil), "TOKEN")
+	require.Empty(t, script)
+	require.NoError(t, c.Flags().Parse([]string{"--service-variable", "TOKEN"}))
+	require.NoError(t, c.PreRunE(c, nil))
+	require.Equal(t, s, script)
+}
@@ -21,8 +21,6 @@ func init() {
 
 var (
 	contactsListFlags = upapi.ContactListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	contactsListCmd = &cobra.Command{
@@ -45,11 +43,7 @@ func init() {
 }
 
 func contactsList(ctx context.Context) ([]upapi.Contact, error) {
-	result, err := api.Contacts().List(ctx, contactsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Contacts(), contactsListFlags, contactsListFlags.Page)
 }
 
 var contactsGetCmd = &cobra.Command{
@@ -67,7 +61,7 @@ func init() {
 }
 
 func contactsGet(ctx context.Context, pkstr string) (*upapi.Contact, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceContact, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +115,7 @@ func init() {
 }
 
 func contactsUpdate(ctx context.Context, arg string) (*upapi.Contact, error) {
-	pk, err := parsePK(arg)
+	pk, err := resolvePK(ctx, upapi.ResourceContact, arg)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +139,7 @@ func init() {
 }
 
 func contactsDelete(ctx context.Context, pkstr string) (*upapi.Contact, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceContact, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -19,8 +19,6 @@ func init() {
 
 var (
 	credentialsListFlags = upapi.CredentialListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	credentialsListCmd = &cobra.Command{
@@ -43,11 +41,7 @@ func init() {
 }
 
 func credentialsList(ctx context.Context) ([]upapi.Credential, error) {
-	result, err := api.Credentials().List(ctx, credentialsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Credentials(), credentialsListFlags, credentialsListFlags.Page)
 }
 
 var credentialsGetCmd = &cobra.Command{
@@ -65,7 +59,7 @@ func init() {
 }
 
 func credentialsGet(ctx context.Context, pkstr string) (*upapi.Credential, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceCredential, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +113,7 @@ func init() {
 }
 
 func credentialsUpdate(ctx context.Context, arg string) (*upapi.Credential, error) {
-	pk, err := parsePK(arg)
+	pk, err := resolvePK(ctx, upapi.ResourceCredential, arg)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +135,7 @@ func init() {
 }
 
 func credentialsDelete(ctx context.Context, pkstr string) (*upapi.Credential, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceCredential, pkstr)
 	if err != nil {
 		return nil, err
 	}
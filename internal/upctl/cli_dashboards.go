@@ -19,8 +19,6 @@ func init() {
 
 var (
 	dashboardsListFlags = upapi.DashboardListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	dashboardsListCmd = &cobra.Command{
@@ -43,11 +41,7 @@ func init() {
 }
 
 func dashboardsList(ctx context.Context) ([]upapi.Dashboard, error) {
-	result, err := api.Dashboards().List(ctx, dashboardsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Dashboards(), dashboardsListFlags, dashboardsListFlags.Page)
 }
 
 var dashboardsGetCmd = &cobra.Command{
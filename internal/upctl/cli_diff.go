@@ -0,0 +1,162 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var (
+	diffFlags = struct {
+		From           string   `flag:"from" usage:"Profile or manifest directory to compare, the current account by default"`
+		To             string   `flag:"to" usage:"Profile or manifest directory to compare with, the current account by default"`
+		FromSubaccount int64    `flag:"from-subaccount" usage:"Subaccount of the --from account to compare"`
+		ToSubaccount   int64    `flag:"to-subaccount" usage:"Subaccount of the --to account to compare with"`
+		Kind           []string `flag:"kind" usage:"Only compare resources of this kind, may be repeated"`
+		NoFail         bool     `flag:"no-fail" usage:"Exit with status 0 even when differences are found"`
+	}{}
+	diffCmd = &cobra.Command{
+		Use:   "diff --from <profile|path> --to <profile|path>",
+		Short: "Compare two accounts, subaccounts or manifest directories",
+		Long: `Compare the resources of two accounts, matched by kind and name, and print
+the differences as JSON: the resources found on one side only, and the fields
+which differ, server-managed fields and secrets aside. The command exits with
+a non-zero status when there are differences.
+
+Each side is the current account, a profile of the profiles file, or a
+directory of manifests such as upctl export writes, and may be narrowed to a
+subaccount:
+
+  upctl diff --from staging --to production
+  upctl diff --from-subaccount 1234            # subaccount against main account
+  upctl diff --from config/                    # manifests against live
+
+Unless --kind is given, a manifest directory is only compared for the kinds
+it holds manifests of. Live resources sharing their kind and name with
+another cannot be matched: they are left out with a warning.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			diffs, err := diffRun(cmd.Context())
+			if err := output(diffs, err); err != nil {
+				return err
+			}
+			if len(diffs) > 0 && !diffFlags.NoFail {
+				return fmt.Errorf("%d resources differ", len(diffs))
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	err := Bind(diffCmd.Flags(), &diffFlags)
+	if err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(diffCmd)
+}
+
+// diffSide is one side of a diff: manifest files, or an account to export.
+type diffSide struct {
+	manifests []upapi.Manifest
+	files     bool
+	profile   profile
+}
+
+func diffRun(ctx context.Context) ([]upapi.ResourceDiff, error) {
+	if diffFlags.From == diffFlags.To && diffFlags.FromSubaccount == diffFlags.ToSubaccount {
+		return nil, fmt.Errorf("nothing to compare, --from and --to are the same")
+	}
+	from, err := newDiffSide(diffFlags.From, diffFlags.FromSubaccount)
+	if err != nil {
+		return nil, fmt.Errorf("--from: %w", err)
+	}
+	to, err := newDiffSide(diffFlags.To, diffFlags.ToSubaccount)
+	if err != nil {
+		return nil, fmt.Errorf("--to: %w", err)
+	}
+	var kinds []upapi.Kind
+	for _, k := range diffFlags.Kind {
+		kinds = append(kinds, upapi.Kind(k))
+	}
+	if len(kinds) == 0 {
+		seen := make(map[upapi.Kind]bool)
+		for _, side := range []*diffSide{from, to} {
+			for _, m := range side.manifests {
+				if !seen[m.Kind] {
+					seen[m.Kind] = true
+					kinds = append(kinds, m.Kind)
+				}
+			}
+		}
+	}
+	for _, side := range []*diffSide{from, to} {
+		if err := side.load(ctx, kinds); err != nil {
+			return nil, err
+		}
+	}
+	return upapi.DiffManifests(from.manifests, to.manifests)
+}
+
+// newDiffSide reads the manifests at source when it is a path, or returns the
+// account of the profile it names, or of the current profile when empty.
+func newDiffSide(source string, subaccount int64) (*diffSide, error) {
+	if _, err := os.Stat(source); source != "" && err == nil {
+		if subaccount != 0 {
+			return nil, fmt.Errorf("a subaccount cannot be selected for manifest files")
+		}
+		manifests, err := upapi.LoadManifests(source)
+		if err != nil {
+			return nil, err
+		}
+		return &diffSide{manifests: manifests, files: true}, nil
+	}
+	side := &diffSide{profile: account}
+	if source != "" {
+		p, err := readProfile(source)
+		if err != nil {
+			return nil, err
+		}
+		side.profile = p
+	}
+	if subaccount != 0 {
+		side.profile.Subaccount = subaccount
+	}
+	return side, nil
+}
+
+// load exports the manifests of the account of s, or keeps those of kinds
+// when s holds manifest files.
+func (s *diffSide) load(ctx context.Context, kinds []upapi.Kind) error {
+	if s.files {
+		if len(diffFlags.Kind) == 0 {
+			return nil
+		}
+		want := make(map[upapi.Kind]bool)
+		for _, k := range kinds {
+			want[k] = true
+		}
+		var manifests []upapi.Manifest
+		for _, m := range s.manifests {
+			if want[m.Kind] {
+				manifests = append(manifests, m)
+			}
+		}
+		s.manifests = manifests
+		return nil
+	}
+	client := api
+	if s.profile != account {
+		var err error
+		if client, err = newAPI(s.profile); err != nil {
+			return err
+		}
+	}
+	var err error
+	s.manifests, err = exportManifests(ctx, client, kinds...)
+	return err
+}
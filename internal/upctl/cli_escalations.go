@@ -0,0 +1,203 @@
+package upctl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var (
+	escalationsFlags = struct {
+		Templates string `flag:"templates" short:"f" usage:"YAML file holding the escalation templates"`
+	}{
+		Templates: "escalations.yaml",
+	}
+	escalationsCmd = &cobra.Command{
+		Use:     "escalations",
+		Aliases: []string{"escalation", "esc"},
+		Short:   "Manage check escalations and escalation templates",
+		Long: `Escalation templates are kept in a YAML file:
+
+  templates:
+    - name: critical
+      tags: [prod]
+      escalations:
+        - wait_time: 5
+          num_repeats: 2
+          contact_groups: [On call]
+
+Each template applies to the checks carrying any of its tags.`,
+		Args: cobra.NoArgs,
+	}
+)
+
+func init() {
+	err := Bind(escalationsCmd.PersistentFlags(), &escalationsFlags)
+	if err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(escalationsCmd)
+}
+
+var escalationsGetCmd = &cobra.Command{
+	Use:     "get <check-pk>",
+	Aliases: []string{"show"},
+	Short:   "Get the escalations of a check",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return output(escalationsGet(cmd.Context(), args[0]))
+	},
+}
+
+func init() {
+	escalationsCmd.AddCommand(escalationsGetCmd)
+}
+
+func escalationsGet(ctx context.Context, pkstr string) (*upapi.CheckEscalations, error) {
+	pk, err := resolvePK(ctx, upapi.ResourceCheck, pkstr)
+	if err != nil {
+		return nil, err
+	}
+	return api.Checks().GetEscalations(ctx, upapi.PrimaryKey(pk))
+}
+
+var escalationsTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List the escalation templates",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return output(readEscalationTemplates(escalationsFlags.Templates))
+	},
+}
+
+func init() {
+	escalationsCmd.AddCommand(escalationsTemplatesCmd)
+}
+
+var (
+	escalationsApplyFlags = struct {
+		Tag    []string `flag:"tag" usage:"Apply to the checks carrying any of these tags instead of the template's own"`
+		DryRun bool     `flag:"dry-run" usage:"Only report the changes"`
+		Yes    bool     `flag:"yes" short:"y" usage:"Apply without asking for confirmation"`
+	}{}
+	escalationsApplyCmd = &cobra.Command{
+		Use:   "apply <template>",
+		Short: "Apply an escalation template to every matching check",
+		Long:  "Print the changes needed for the matching checks to follow the template, then apply them once confirmed.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return escalationsApply(cmd.Context(), args[0])
+		},
+	}
+)
+
+func init() {
+	err := Bind(escalationsApplyCmd.Flags(), &escalationsApplyFlags)
+	if err != nil {
+		panic(err)
+	}
+	escalationsCmd.AddCommand(escalationsApplyCmd)
+}
+
+func escalationsApply(ctx context.Context, name string) error {
+	templates, err := readEscalationTemplates(escalationsFlags.Templates)
+	if err != nil {
+		return err
+	}
+	var tmpl *upapi.EscalationTemplate
+	for i := range templates {
+		if templates[i].Name == name {
+			tmpl = &templates[i]
+		}
+	}
+	if tmpl == nil {
+		return fmt.Errorf("no escalation template named %q in %s", name, escalationsFlags.Templates)
+	}
+	if len(tmpl.Tags) == 0 && len(escalationsApplyFlags.Tag) == 0 {
+		return fmt.Errorf("escalation template %q has no tags, select checks with --tag", name)
+	}
+	changes, err := upapi.PlanEscalations(ctx, api.Checks(), *tmpl, escalationsApplyFlags.Tag...)
+	if err != nil {
+		return err
+	}
+	if err := output(changes, nil); err != nil {
+		return err
+	}
+	if len(changes) == 0 || escalationsApplyFlags.DryRun {
+		return nil
+	}
+	if !escalationsApplyFlags.Yes && !confirm(fmt.Sprintf("Apply template %q to %d checks?", name, len(changes))) {
+		return fmt.Errorf("aborted")
+	}
+	_, err = upapi.ApplyEscalations(ctx, api.Checks(), changes)
+	return err
+}
+
+var escalationsVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check every check follows the escalation template assigned to it",
+	Long: "Report the checks whose escalations differ from their template and those assigned to several templates, " +
+		"as JSON. The command exits with a non-zero status when the report is not empty.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := escalationsVerify(cmd.Context())
+		if err := output(report, err); err != nil {
+			return err
+		}
+		if !report.OK() {
+			return fmt.Errorf("%d checks do not follow their escalation template", len(report.Changes)+len(report.Conflicts))
+		}
+		return nil
+	},
+}
+
+func init() {
+	escalationsCmd.AddCommand(escalationsVerifyCmd)
+}
+
+func escalationsVerify(ctx context.Context) (*upapi.EscalationReport, error) {
+	templates, err := readEscalationTemplates(escalationsFlags.Templates)
+	if err != nil {
+		return nil, err
+	}
+	return upapi.AuditEscalations(ctx, api.Checks(), templates)
+}
+
+func readEscalationTemplates(path string) ([]upapi.EscalationTemplate, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Templates []upapi.EscalationTemplate `yaml:"templates"`
+	}
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	seen := make(map[string]bool)
+	for _, t := range file.Templates {
+		if t.Name == "" {
+			return nil, fmt.Errorf("%s: escalation template without a name", path)
+		}
+		if seen[t.Name] {
+			return nil, fmt.Errorf("%s: duplicate escalation template %q", path, t.Name)
+		}
+		seen[t.Name] = true
+	}
+	return file.Templates, nil
+}
+
+// confirm asks question on stderr and reports whether the user answered yes.
+func confirm(question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
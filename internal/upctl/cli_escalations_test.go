@@ -0,0 +1,38 @@
+package upctl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestReadEscalationTemplates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "escalations.yaml")
+	err := os.WriteFile(path, []byte(`
+templates:
+  - name: critical
+    tags: [prod]
+    escalations:
+      - wait_time: 5
+        num_repeats: 2
+        contact_groups: [On call]
+`), 0o600)
+	require.NoError(t, err)
+
+	templates, err := readEscalationTemplates(path)
+	require.NoError(t, err)
+	require.Equal(t, []upapi.EscalationTemplate{{
+		Name:        "critical",
+		Tags:        []string{"prod"},
+		Escalations: []upapi.CheckEscalation{{WaitTime: 5, NumRepeats: 2, ContactGroups: &[]string{"On call"}}},
+	}}, templates)
+
+	err = os.WriteFile(path, []byte("templates:\n  - name: a\n  - name: a\n"), 0o600)
+	require.NoError(t, err)
+	_, err = readEscalationTemplates(path)
+	require.ErrorContains(t, err, `duplicate escalation template "a"`)
+}
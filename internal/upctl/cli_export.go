@@ -0,0 +1,120 @@
+package upctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var (
+	exportFlags = struct {
+		Dir    string   `flag:"dir" short:"d" usage:"Directory to write the files to, instead of printing them"`
+		Kind   []string `flag:"kind" usage:"Only export resources of this kind, may be repeated"`
+		Format string   `flag:"format" usage:"Format of the export (yaml|terraform)"`
+	}{
+		Format: "yaml",
+	}
+	exportCmd = &cobra.Command{
+		Use:   "export [--dir <path>] [--format yaml|terraform]",
+		Short: "Export the account as manifests or Terraform configuration",
+		Long: `Export every resource of the account, or those of the kinds given with
+--kind, as manifests which upctl apply accepts. Nested resources, such as the
+service variables of a check or the components of a status page, are listed in
+the spec of their parent, and other resources are referred to by name.
+Server-managed fields are left out, and so are secrets, which the API does
+not return. Resources sharing their kind and name with another cannot be told
+apart in manifests: they are left out with a warning.
+
+With --dir, each manifest is written to <dir>/<kind>/<name>.yaml; otherwise
+the manifests are printed as a YAML stream.
+
+With --format terraform, tags, contacts, integrations, checks and status pages
+with their components are exported as resources of the Uptime.com Terraform
+provider instead, followed by import blocks binding them to the live
+resources. With --dir, they are written to <dir>/uptime.tf. Fields the
+provider has no attribute for, such as the escalations of checks, are left
+out with a warning and named in a comment of their resource block.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportRun(cmd.Context())
+		},
+	}
+)
+
+func init() {
+	err := Bind(exportCmd.Flags(), &exportFlags)
+	if err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(exportCmd)
+}
+
+func exportRun(ctx context.Context) error {
+	kinds := make([]upapi.Kind, len(exportFlags.Kind))
+	for i, k := range exportFlags.Kind {
+		kinds[i] = upapi.Kind(k)
+	}
+	switch exportFlags.Format {
+	case "yaml":
+	case "terraform":
+		return exportTerraform(ctx, kinds)
+	default:
+		return fmt.Errorf("invalid export format %q", exportFlags.Format)
+	}
+	manifests, err := exportManifests(ctx, api, kinds...)
+	if err != nil {
+		return err
+	}
+	if exportFlags.Dir == "" {
+		return upapi.EncodeManifests(os.Stdout, manifests...)
+	}
+	return upapi.WriteManifests(exportFlags.Dir, manifests...)
+}
+
+// exportManifests exports the manifests of the account of client, warning
+// about the resources left out because their names are ambiguous.
+func exportManifests(ctx context.Context, client upapi.API, kinds ...upapi.Kind) ([]upapi.Manifest, error) {
+	manifests, err := upapi.Export(ctx, client, kinds...)
+	var dup *upapi.DuplicateNamesError
+	if errors.As(err, &dup) {
+		for _, d := range dup.Duplicates {
+			fmt.Fprintf(os.Stderr, "Warning: %s, left out\n", d)
+		}
+		return manifests, nil
+	}
+	return manifests, err
+}
+
+func exportTerraform(ctx context.Context, kinds []upapi.Kind) error {
+	resources, err := upapi.ExportTerraform(ctx, api, kinds...)
+	if err != nil {
+		return err
+	}
+	for _, r := range resources {
+		if len(r.Omitted) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %s not exported, no provider attribute\n", r.Address(), strings.Join(r.Omitted, ", "))
+		}
+	}
+	if exportFlags.Dir == "" {
+		return upapi.EncodeTerraform(os.Stdout, resources...)
+	}
+	if err := os.MkdirAll(exportFlags.Dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(exportFlags.Dir, "uptime.tf"))
+	if err != nil {
+		return err
+	}
+	err = upapi.EncodeTerraform(f, resources...)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
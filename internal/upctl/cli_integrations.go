@@ -22,8 +22,6 @@ func init() {
 
 var (
 	integrationsListFlags = upapi.IntegrationListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	integrationsListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func integrationsList(ctx context.Context) ([]upapi.Integration, error) {
-	result, err := api.Integrations().List(ctx, integrationsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Integrations(), integrationsListFlags, integrationsListFlags.Page)
 }
 
 var (
@@ -70,7 +64,7 @@ func init() {
 }
 
 func integrationsGet(ctx context.Context, pkstr string) (*upapi.Integration, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceIntegration, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +187,7 @@ func integrationsUpdateSubcommand(name string, flags any, fn func(context.Contex
 		Short: "Update " + name + " integration",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pk, err := parsePK(args[0])
+			pk, err := resolvePK(cmd.Context(), upapi.ResourceIntegration, args[0])
 			if err != nil {
 				return err
 			}
@@ -317,7 +311,7 @@ func init() {
 }
 
 func integrationsDelete(ctx context.Context, pkstr string) (*upapi.Integration, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceIntegration, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,114 @@
+package upctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+var (
+	migrateFlags = struct {
+		SourceSubaccount int64    `flag:"source-subaccount" usage:"Subaccount to copy from, the main account when 0"`
+		TargetSubaccount int64    `flag:"target-subaccount" usage:"Subaccount to copy to, the main account when 0"`
+		State            string   `flag:"state" usage:"State file, migrate-<source>-<target>.json by default"`
+		Kind             []string `flag:"kind" usage:"Only copy resources of this kind, may be repeated"`
+		UpdateExisting   bool     `flag:"update-existing" usage:"Update the resources the target already has, instead of leaving them as they are"`
+		DryRun           bool     `flag:"dry-run" usage:"Only print the plan"`
+		Yes              bool     `flag:"yes" short:"y" usage:"Migrate without asking for confirmation"`
+	}{}
+	migrateCmd = &cobra.Command{
+		Use:   "migrate --source-subaccount <pk> --target-subaccount <pk>",
+		Short: "Copy the configuration of a subaccount to another",
+		Long: `Copy the tags, contacts, integrations, checks, dashboards, SLA reports and
+status pages of a subaccount to another, or only the resources of the kinds
+given with --kind. Resources are matched by kind and name: those missing from
+the target are created, and references between them, such as the contact
+groups of checks or the checks of status page components, point at the
+copies. The resources the target already has are left as they are, unless
+--update-existing is given: they are then overwritten with those of the
+source. Resources sharing their kind and name with another in the
+source cannot be matched, and are skipped.
+
+The API does not return secrets, so the integrations created get CHANGE_ME
+placeholders to replace. Service variables, status page incidents,
+subscribers and users are not copied.
+
+Progress is saved to the state file after every change, along with the primary
+keys of the copies. Running the command again resumes an interrupted
+migration; the resources the state file records are not updated again.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateRun(cmd.Context())
+		},
+	}
+)
+
+func init() {
+	err := Bind(migrateCmd.Flags(), &migrateFlags)
+	if err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(migrateCmd)
+}
+
+func migrateRun(ctx context.Context) error {
+	from, to := migrateFlags.SourceSubaccount, migrateFlags.TargetSubaccount
+	if from == to {
+		return fmt.Errorf("the source and target subaccounts are the same")
+	}
+	source, err := newAPI(profile{Token: account.Token, Subaccount: from})
+	if err != nil {
+		return err
+	}
+	target, err := newAPI(profile{Token: account.Token, Subaccount: to})
+	if err != nil {
+		return err
+	}
+	path := migrateFlags.State
+	if path == "" {
+		path = fmt.Sprintf("migrate-%d-%d.json", from, to)
+	}
+	state, err := upapi.LoadMigrationState(path, from, to)
+	if err != nil {
+		return err
+	}
+	opts := upapi.MigrateOptions{UpdateExisting: migrateFlags.UpdateExisting}
+	for _, k := range migrateFlags.Kind {
+		opts.Kinds = append(opts.Kinds, upapi.Kind(k))
+	}
+	plan, err := upapi.PlanMigration(ctx, source, target, state, opts)
+	if err != nil {
+		return err
+	}
+	if err := output(plan, nil); err != nil {
+		return err
+	}
+	if plan.Empty() || migrateFlags.DryRun {
+		return nil
+	}
+	var notes []string
+	updates := 0
+	for _, c := range plan.Changes {
+		if c.Action == upapi.ChangeUpdate {
+			updates++
+		}
+	}
+	if updates > 0 {
+		notes = append(notes, fmt.Sprintf("overwriting %d existing resources", updates))
+	}
+	if len(plan.Skipped) > 0 {
+		notes = append(notes, fmt.Sprintf("leaving out the %d skipped names", len(plan.Skipped)))
+	}
+	question := fmt.Sprintf("Make these %d changes?", len(plan.Changes))
+	if len(notes) > 0 {
+		question = fmt.Sprintf("Make these %d changes, %s?", len(plan.Changes), strings.Join(notes, " and "))
+	}
+	if !migrateFlags.Yes && !confirm(question) {
+		return fmt.Errorf("aborted")
+	}
+	return upapi.ApplyMigration(ctx, target, plan, state)
+}
@@ -21,8 +21,6 @@ func init() {
 
 var (
 	outagesListFlags = upapi.OutageListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	outagesListCmd = &cobra.Command{
@@ -45,9 +43,5 @@ func init() {
 }
 
 func outagesList(ctx context.Context) ([]upapi.Outage, error) {
-	result, err := api.Outages().List(ctx, outagesListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Outages(), outagesListFlags, outagesListFlags.Page)
 }
@@ -22,8 +22,6 @@ func init() {
 
 var (
 	pushNotificationsListFlags = upapi.PushNotificationProfileListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	pushNotificationsListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func pushNotificationsList(ctx context.Context) ([]upapi.PushNotificationProfile, error) {
-	result, err := api.PushNotifications().List(ctx, pushNotificationsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.PushNotifications(), pushNotificationsListFlags, pushNotificationsListFlags.Page)
 }
 
 var (
@@ -22,8 +22,6 @@ func init() {
 
 var (
 	scheduledReportsListFlags = upapi.ScheduledReportListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	scheduledReportsListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func scheduledReportsList(ctx context.Context) ([]upapi.ScheduledReport, error) {
-	result, err := api.ScheduledReports().List(ctx, scheduledReportsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.ScheduledReports(), scheduledReportsListFlags, scheduledReportsListFlags.Page)
 }
 
 var scheduledReportsGetCmd = &cobra.Command{
@@ -22,8 +22,6 @@ func init() {
 
 var (
 	serviceVariablesListFlags = upapi.ServiceVariableListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	serviceVariablesListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func serviceVariablesList(ctx context.Context) ([]upapi.ServiceVariable, error) {
-	result, err := api.ServiceVariables().List(ctx, serviceVariablesListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.ServiceVariables(), serviceVariablesListFlags, serviceVariablesListFlags.Page)
 }
 
 var (
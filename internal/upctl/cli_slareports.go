@@ -22,8 +22,6 @@ func init() {
 
 var (
 	slaReportsListFlags = upapi.SLAReportListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	slaReportsListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func slaReportsList(ctx context.Context) ([]upapi.SLAReport, error) {
-	result, err := api.SLAReports().List(ctx, slaReportsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.SLAReports(), slaReportsListFlags, slaReportsListFlags.Page)
 }
 
 var slaReportsGetCmd = &cobra.Command{
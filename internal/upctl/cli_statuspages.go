@@ -22,8 +22,6 @@ func init() {
 
 var (
 	statusPagesListFlags = upapi.StatusPageListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	statusPagesListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func statusPagesList(ctx context.Context) ([]upapi.StatusPage, error) {
-	result, err := api.StatusPages().List(ctx, statusPagesListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages(), statusPagesListFlags, statusPagesListFlags.Page)
 }
 
 var statusPagesGetCmd = &cobra.Command{
@@ -68,7 +62,7 @@ func init() {
 }
 
 func statusPagesGet(ctx context.Context, pkstr string) (*upapi.StatusPage, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +120,7 @@ func init() {
 }
 
 func statusPagesUpdate(ctx context.Context, pkstr string) (*upapi.StatusPage, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +144,7 @@ func init() {
 }
 
 func statusPagesDelete(ctx context.Context, pkstr string) (*upapi.StatusPage, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +174,7 @@ func init() {
 }
 
 func statusPagesCurrentStatus(ctx context.Context, pkstr string) (*upapi.StatusPageCurrentStatus, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -191,8 +185,6 @@ func statusPagesCurrentStatus(ctx context.Context, pkstr string) (*upapi.StatusP
 
 var (
 	statusPagesStatusHistoryListFlags = upapi.StatusPageStatusHistoryListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "-created_at",
 	}
 	statusPagesStatusHistoryListCmd = &cobra.Command{
@@ -215,15 +207,11 @@ func init() {
 }
 
 func statusPagesStatusHistoryList(ctx context.Context, pkstr string) ([]upapi.StatusPageStatusHistory, error) {
-	pk, err := parsePK(pkstr)
-	if err != nil {
-		return nil, err
-	}
-	result, err := api.StatusPages().StatusHistory(upapi.PrimaryKey(pk)).List(ctx, statusPagesStatusHistoryListFlags)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().StatusHistory(upapi.PrimaryKey(pk)), statusPagesStatusHistoryListFlags, statusPagesStatusHistoryListFlags.Page)
 }
 
 var (
@@ -242,7 +230,7 @@ func init() {
 }
 
 func statusPagesStatusHistoryGet(ctx context.Context, statusPagePKStr, historyPKStr string) (*upapi.StatusPageStatusHistory, error) {
-	statusPagePK, err := parsePK(statusPagePKStr)
+	statusPagePK, err := resolvePK(ctx, upapi.ResourceStatusPage, statusPagePKStr)
 	if err != nil {
 		return nil, err
 	}
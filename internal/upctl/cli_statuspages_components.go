@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spComponentsListFlags = upapi.StatusPageComponentListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spComponentsListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spComponentsList(ctx context.Context, pkstr string) ([]upapi.StatusPageComponent, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().Components(upapi.PrimaryKey(pk)).List(ctx, spComponentsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().Components(upapi.PrimaryKey(pk)), spComponentsListFlags, spComponentsListFlags.Page)
 }
 
 var spComponentsGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spComponentsGet(ctx context.Context, spPKStr, compPKStr string) (*upapi.StatusPageComponent, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +78,7 @@ func spComponentsGet(ctx context.Context, spPKStr, compPKStr string) (*upapi.Sta
 
 var (
 	spComponentsCreateFlags upapi.StatusPageComponent
+	spComponentsService     string
 	spComponentsCreateCmd   = &cobra.Command{
 		Use:     "create <status-page-pk>",
 		Aliases: []string{"new"},
@@ -100,14 +95,22 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	spComponentsCreateCmd.Flags().StringVar(&spComponentsService, "service", "", "Name or PK of the check to follow")
 	spComponentsCmd.AddCommand(spComponentsCreateCmd)
 }
 
 func spComponentsCreate(ctx context.Context, pkstr string) (*upapi.StatusPageComponent, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
+	if spComponentsService != "" {
+		svc, err := resolvePK(ctx, upapi.ResourceCheck, spComponentsService)
+		if err != nil {
+			return nil, err
+		}
+		spComponentsCreateFlags.ServiceID = &svc
+	}
 	return api.StatusPages().Components(upapi.PrimaryKey(pk)).Create(ctx, spComponentsCreateFlags)
 }
 
@@ -129,11 +132,12 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	spComponentsUpdateCmd.Flags().StringVar(&spComponentsService, "service", "", "Name or PK of the check to follow")
 	spComponentsCmd.AddCommand(spComponentsUpdateCmd)
 }
 
 func spComponentsUpdate(ctx context.Context, spPKStr, compPKStr string) (*upapi.StatusPageComponent, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +145,13 @@ func spComponentsUpdate(ctx context.Context, spPKStr, compPKStr string) (*upapi.
 	if err != nil {
 		return nil, err
 	}
+	if spComponentsService != "" {
+		svc, err := resolvePK(ctx, upapi.ResourceCheck, spComponentsService)
+		if err != nil {
+			return nil, err
+		}
+		spComponentsUpdateFlags.ServiceID = &svc
+	}
 	return api.StatusPages().Components(upapi.PrimaryKey(spPK)).Update(ctx, upapi.PrimaryKey(compPK), spComponentsUpdateFlags)
 }
 
@@ -159,7 +170,7 @@ func init() {
 }
 
 func spComponentsDelete(ctx context.Context, spPKStr, compPKStr string) (*upapi.StatusPageComponent, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
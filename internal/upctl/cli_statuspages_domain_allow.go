@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spDomainAllowListFlags = upapi.StatusPageSubsDomainAllowListListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spDomainAllowListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spDomainAllowList(ctx context.Context, pkstr string) ([]upapi.StatusPageSubsDomainAllowList, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().SubscriptionDomainAllowList(upapi.PrimaryKey(pk)).List(ctx, spDomainAllowListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().SubscriptionDomainAllowList(upapi.PrimaryKey(pk)), spDomainAllowListFlags, spDomainAllowListFlags.Page)
 }
 
 var spDomainAllowGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spDomainAllowGet(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainAllowList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +98,7 @@ func init() {
 }
 
 func spDomainAllowCreate(ctx context.Context, pkstr string) (*upapi.StatusPageSubsDomainAllowList, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +127,7 @@ func init() {
 }
 
 func spDomainAllowUpdate(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainAllowList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +153,7 @@ func init() {
 }
 
 func spDomainAllowDelete(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainAllowList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spDomainBlockListFlags = upapi.StatusPageSubsDomainBlockListListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spDomainBlockListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spDomainBlockList(ctx context.Context, pkstr string) ([]upapi.StatusPageSubsDomainBlockList, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().SubscriptionDomainBlockList(upapi.PrimaryKey(pk)).List(ctx, spDomainBlockListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().SubscriptionDomainBlockList(upapi.PrimaryKey(pk)), spDomainBlockListFlags, spDomainBlockListFlags.Page)
 }
 
 var spDomainBlockGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spDomainBlockGet(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainBlockList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +98,7 @@ func init() {
 }
 
 func spDomainBlockCreate(ctx context.Context, pkstr string) (*upapi.StatusPageSubsDomainBlockList, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +127,7 @@ func init() {
 }
 
 func spDomainBlockUpdate(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainBlockList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +153,7 @@ func init() {
 }
 
 func spDomainBlockDelete(ctx context.Context, spPKStr, domPKStr string) (*upapi.StatusPageSubsDomainBlockList, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
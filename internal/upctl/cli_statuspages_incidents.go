@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spIncidentsListFlags = upapi.StatusPageIncidentListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spIncidentsListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spIncidentsList(ctx context.Context, pkstr string) ([]upapi.StatusPageIncident, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().Incidents(upapi.PrimaryKey(pk)).List(ctx, spIncidentsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().Incidents(upapi.PrimaryKey(pk)), spIncidentsListFlags, spIncidentsListFlags.Page)
 }
 
 var spIncidentsGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spIncidentsGet(ctx context.Context, spPKStr, incPKStr string) (*upapi.StatusPageIncident, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +125,7 @@ func init() {
 }
 
 func spIncidentsCreate(ctx context.Context, pkstr string) (*upapi.StatusPageIncident, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +154,7 @@ func init() {
 }
 
 func spIncidentsUpdate(ctx context.Context, spPKStr, incPKStr string) (*upapi.StatusPageIncident, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +180,7 @@ func init() {
 }
 
 func spIncidentsDelete(ctx context.Context, spPKStr, incPKStr string) (*upapi.StatusPageIncident, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spMetricsListFlags = upapi.StatusPageMetricListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spMetricsListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spMetricsList(ctx context.Context, pkstr string) ([]upapi.StatusPageMetric, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().Metrics(upapi.PrimaryKey(pk)).List(ctx, spMetricsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().Metrics(upapi.PrimaryKey(pk)), spMetricsListFlags, spMetricsListFlags.Page)
 }
 
 var spMetricsGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spMetricsGet(ctx context.Context, spPKStr, metricPKStr string) (*upapi.StatusPageMetric, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +78,7 @@ func spMetricsGet(ctx context.Context, spPKStr, metricPKStr string) (*upapi.Stat
 
 var (
 	spMetricsCreateFlags upapi.StatusPageMetric
+	spMetricsService     string
 	spMetricsCreateCmd   = &cobra.Command{
 		Use:     "create <status-page-pk>",
 		Aliases: []string{"new"},
@@ -100,14 +95,22 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	spMetricsCreateCmd.Flags().StringVar(&spMetricsService, "service", "", "Name or PK of the check to follow")
 	spMetricsCmd.AddCommand(spMetricsCreateCmd)
 }
 
 func spMetricsCreate(ctx context.Context, pkstr string) (*upapi.StatusPageMetric, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
+	if spMetricsService != "" {
+		svc, err := resolvePK(ctx, upapi.ResourceCheck, spMetricsService)
+		if err != nil {
+			return nil, err
+		}
+		spMetricsCreateFlags.ServiceID = svc
+	}
 	return api.StatusPages().Metrics(upapi.PrimaryKey(pk)).Create(ctx, spMetricsCreateFlags)
 }
 
@@ -129,11 +132,12 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	spMetricsUpdateCmd.Flags().StringVar(&spMetricsService, "service", "", "Name or PK of the check to follow")
 	spMetricsCmd.AddCommand(spMetricsUpdateCmd)
 }
 
 func spMetricsUpdate(ctx context.Context, spPKStr, metricPKStr string) (*upapi.StatusPageMetric, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +145,13 @@ func spMetricsUpdate(ctx context.Context, spPKStr, metricPKStr string) (*upapi.S
 	if err != nil {
 		return nil, err
 	}
+	if spMetricsService != "" {
+		svc, err := resolvePK(ctx, upapi.ResourceCheck, spMetricsService)
+		if err != nil {
+			return nil, err
+		}
+		spMetricsUpdateFlags.ServiceID = svc
+	}
 	return api.StatusPages().Metrics(upapi.PrimaryKey(spPK)).Update(ctx, upapi.PrimaryKey(metricPK), spMetricsUpdateFlags)
 }
 
@@ -159,7 +170,7 @@ func init() {
 }
 
 func spMetricsDelete(ctx context.Context, spPKStr, metricPKStr string) (*upapi.StatusPageMetric, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spSubscribersListFlags = upapi.StatusPageSubscriberListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spSubscribersListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spSubscribersList(ctx context.Context, pkstr string) ([]upapi.StatusPageSubscriber, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().Subscribers(upapi.PrimaryKey(pk)).List(ctx, spSubscribersListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().Subscribers(upapi.PrimaryKey(pk)), spSubscribersListFlags, spSubscribersListFlags.Page)
 }
 
 var spSubscribersGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spSubscribersGet(ctx context.Context, spPKStr, subPKStr string) (*upapi.StatusPageSubscriber, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +98,7 @@ func init() {
 }
 
 func spSubscribersCreate(ctx context.Context, pkstr string) (*upapi.StatusPageSubscriber, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +120,7 @@ func init() {
 }
 
 func spSubscribersDelete(ctx context.Context, spPKStr, subPKStr string) (*upapi.StatusPageSubscriber, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
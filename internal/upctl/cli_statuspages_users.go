@@ -21,8 +21,6 @@ func init() {
 
 var (
 	spUsersListFlags = upapi.StatusPageUserListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	spUsersListCmd = &cobra.Command{
@@ -45,15 +43,11 @@ func init() {
 }
 
 func spUsersList(ctx context.Context, pkstr string) ([]upapi.StatusPageUser, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
-	result, err := api.StatusPages().Users(upapi.PrimaryKey(pk)).List(ctx, spUsersListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.StatusPages().Users(upapi.PrimaryKey(pk)), spUsersListFlags, spUsersListFlags.Page)
 }
 
 var spUsersGetCmd = &cobra.Command{
@@ -71,7 +65,7 @@ func init() {
 }
 
 func spUsersGet(ctx context.Context, spPKStr, userPKStr string) (*upapi.StatusPageUser, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +98,7 @@ func init() {
 }
 
 func spUsersCreate(ctx context.Context, pkstr string) (*upapi.StatusPageUser, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceStatusPage, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +127,7 @@ func init() {
 }
 
 func spUsersUpdate(ctx context.Context, spPKStr, userPKStr string) (*upapi.StatusPageUser, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +153,7 @@ func init() {
 }
 
 func spUsersDelete(ctx context.Context, spPKStr, userPKStr string) (*upapi.StatusPageUser, error) {
-	spPK, err := parsePK(spPKStr)
+	spPK, err := resolvePK(ctx, upapi.ResourceStatusPage, spPKStr)
 	if err != nil {
 		return nil, err
 	}
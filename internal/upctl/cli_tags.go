@@ -22,8 +22,6 @@ func init() {
 
 var (
 	tagsListFlags = upapi.TagListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	tagsListCmd = &cobra.Command{
@@ -46,11 +44,7 @@ func init() {
 }
 
 func tagsList(ctx context.Context) ([]upapi.Tag, error) {
-	result, err := api.Tags().List(ctx, tagsListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Tags(), tagsListFlags, tagsListFlags.Page)
 }
 
 var tagsGetCmd = &cobra.Command{
@@ -68,7 +62,7 @@ func init() {
 }
 
 func tagsGet(ctx context.Context, pkstr string) (*upapi.Tag, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceTag, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +118,7 @@ func init() {
 }
 
 func tagsUpdate(ctx context.Context, pkstr string) (*upapi.Tag, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceTag, pkstr)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +142,7 @@ func init() {
 }
 
 func tagsDelete(ctx context.Context, pkstr string) (*upapi.Tag, error) {
-	pk, err := parsePK(pkstr)
+	pk, err := resolvePK(ctx, upapi.ResourceTag, pkstr)
 	if err != nil {
 		return nil, err
 	}
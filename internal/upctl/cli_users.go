@@ -21,8 +21,6 @@ func init() {
 
 var (
 	usersListFlags = upapi.UserListOptions{
-		Page:     1,
-		PageSize: 100,
 		Ordering: "pk",
 	}
 	usersListCmd = &cobra.Command{
@@ -45,11 +43,7 @@ func init() {
 }
 
 func usersList(ctx context.Context) ([]upapi.User, error) {
-	result, err := api.Users().List(ctx, usersListFlags)
-	if err != nil {
-		return nil, err
-	}
-	return result.Items, nil
+	return listPages(ctx, api.Users(), usersListFlags, usersListFlags.Page)
 }
 
 var usersGetCmd = &cobra.Command{
@@ -0,0 +1,59 @@
+package upctl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// profile holds the credentials of an account, or of one of its subaccounts.
+// Profiles are kept in a YAML file, UPCTL_PROFILES or profiles.yaml in the
+// upctl directory of the user configuration directory:
+//
+//	profiles:
+//	  staging:
+//	    token: ...
+//	    subaccount: 1234
+type profile struct {
+	Token      string `yaml:"token"`
+	Subaccount int64  `yaml:"subaccount"`
+}
+
+func profilesPath() (string, error) {
+	if path := os.Getenv("UPCTL_PROFILES"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "upctl", "profiles.yaml"), nil
+}
+
+// readProfile returns the profile called name.
+func readProfile(name string) (profile, error) {
+	path, err := profilesPath()
+	if err != nil {
+		return profile{}, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return profile{}, err
+	}
+	var file struct {
+		Profiles map[string]profile `yaml:"profiles"`
+	}
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return profile{}, fmt.Errorf("%s: %w", path, err)
+	}
+	p, ok := file.Profiles[name]
+	if !ok {
+		return profile{}, fmt.Errorf("%s: no profile %q", path, name)
+	}
+	if p.Token == "" {
+		return profile{}, fmt.Errorf("%s: profile %q has no token", path, name)
+	}
+	return p, nil
+}
@@ -0,0 +1,33 @@
+package upctl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	t.Setenv("UPCTL_PROFILES", path)
+	err := os.WriteFile(path, []byte(`
+profiles:
+  staging:
+    token: abc
+    subaccount: 1234
+  broken:
+    subaccount: 1
+`), 0o600)
+	require.NoError(t, err)
+
+	p, err := readProfile("staging")
+	require.NoError(t, err)
+	require.Equal(t, profile{Token: "abc", Subaccount: 1234}, p)
+
+	_, err = readProfile("production")
+	require.ErrorContains(t, err, `no profile "production"`)
+
+	_, err = readProfile("broken")
+	require.ErrorContains(t, err, `profile "broken" has no token`)
+}
@@ -1,12 +1,15 @@
 package upctl
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/gobeam/stringy"
 	"github.com/shopspring/decimal"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
 )
 
 type FlagSet interface {
@@ -128,3 +131,32 @@ func parsePK(s string) (int64, error) {
 	}
 	return pk, nil
 }
+
+// resolvePK accepts either a primary key or the name of a resource of kind res.
+func resolvePK(ctx context.Context, res upapi.Resource, s string) (int64, error) {
+	pk, err := resolver.PK(ctx, res, s)
+	if err != nil {
+		return 0, err
+	}
+	return int64(pk), nil
+}
+
+// listPages lists every page of l, or only the given page when --page is set.
+func listPages[Item any, Options any](ctx context.Context, l upapi.Lister[Item, Options], opts Options, page int64) ([]Item, error) {
+	if page > 0 {
+		result, err := l.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return result.Items, nil
+	}
+	return upapi.ListAll(ctx, l, opts)
+}
+
+// listerFunc adapts list methods not named List, such as
+// ListCloudStatusGroups, to upapi.Lister.
+type listerFunc[Item any, Options any] func(context.Context, Options) (*upapi.ListResult[Item], error)
+
+func (f listerFunc[Item, Options]) List(ctx context.Context, opts Options) (*upapi.ListResult[Item], error) {
+	return f(ctx, opts)
+}
@@ -1,12 +1,15 @@
 package upctl
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapitest"
 )
 
 type flagSetMock struct {
@@ -151,3 +154,37 @@ func TestBind(t *testing.T) {
 		})
 	})
 }
+
+func TestListPages(t *testing.T) {
+	fake := upapitest.NewServer()
+	t.Cleanup(fake.Close)
+	for i := 0; i < 120; i++ {
+		fake.Add("check-tags", map[string]any{"tag": fmt.Sprintf("tag-%d", i)})
+	}
+	fake.Add("checks", map[string]any{"name": "up", "check_type": "HTTP"})
+	fake.Add("checks", map[string]any{"name": "paused", "check_type": "HTTP", "is_paused": true})
+
+	saved := api
+	t.Cleanup(func() { api = saved })
+	var err error
+	api, err = upapi.New(upapi.WithBaseURL(fake.URL), upapi.WithToken("test"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tags, err := listPages(ctx, api.Tags(), upapi.TagListOptions{PageSize: 50}, 0)
+	require.NoError(t, err)
+	require.Len(t, tags, 120)
+
+	tags, err = listPages(ctx, api.Tags(), upapi.TagListOptions{Page: 3, PageSize: 50}, 3)
+	require.NoError(t, err)
+	require.Len(t, tags, 20)
+
+	checks, err := checksList(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	checks, err = checksList(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	require.Equal(t, "up", checks[0].Name)
+}
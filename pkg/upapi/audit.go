@@ -0,0 +1,264 @@
+package upapi
+
+import (
+	"context"
+	"sort"
+	"strconv"
+)
+
+const (
+	ResourceDashboard               Resource = "dashboard"
+	ResourceSLAReport               Resource = "SLA report"
+	ResourceStatusPageComponent     Resource = "status page component"
+	ResourceStatusPageMetric        Resource = "status page metric"
+	ResourceServiceVariable         Resource = "service variable"
+	ResourcePushNotificationProfile Resource = "push notification profile"
+)
+
+// RefProblem is the kind of a RefIssue.
+type RefProblem string
+
+const (
+	// RefDangling marks a reference to a resource which does not exist.
+	RefDangling RefProblem = "dangling"
+	// RefUnused marks a resource nothing refers to.
+	RefUnused RefProblem = "unused"
+)
+
+// RefIssue is a single finding of AuditRefs.
+type RefIssue struct {
+	Problem  RefProblem `json:"problem"`
+	Resource Resource   `json:"resource"`
+	PK       PrimaryKey `json:"pk"`
+	Name     string     `json:"name,omitempty"`
+	// StatusPage is the primary key of the status page owning a component or
+	// a metric.
+	StatusPage PrimaryKey `json:"status_page,omitempty"`
+	// Field is the JSON name of the field holding a dangling reference, Target
+	// the kind of resource it refers to and Ref the value which did not
+	// resolve.
+	Field  string   `json:"field,omitempty"`
+	Target Resource `json:"target,omitempty"`
+	Ref    string   `json:"ref,omitempty"`
+}
+
+// RefReport is the result of AuditRefs.
+type RefReport struct {
+	Issues []RefIssue `json:"issues"`
+	// Scanned holds how many resources of each kind were inspected.
+	Scanned map[Resource]int `json:"scanned"`
+}
+
+// OK reports whether the audit found no issue.
+func (r *RefReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// AuditRefs loads the whole account and reports the references between
+// resources which no longer resolve, such as checks alerting contacts that
+// were deleted or status page components following a deleted check, along
+// with the tags no check, dashboard or SLA report uses.
+//
+// References by name are compared exactly, the way the API matches them.
+func AuditRefs(ctx context.Context, api API) (*RefReport, error) {
+	checks, err := ListAllChecks(ctx, api.Checks(), CheckListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	contacts, err := ListAll(ctx, api.Contacts(), ContactListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	integrations, err := ListAll(ctx, api.Integrations(), IntegrationListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := ListAll(ctx, api.PushNotifications(), PushNotificationProfileListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	tags, err := ListAll(ctx, api.Tags(), TagListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	dashboards, err := ListAll(ctx, api.Dashboards(), DashboardListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	reports, err := ListAll(ctx, api.SLAReports(), SLAReportListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pages, err := ListAll(ctx, api.StatusPages(), StatusPageListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	variables, err := ListAll(ctx, api.ServiceVariables(), ServiceVariableListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := ListAll(ctx, api.Credentials(), CredentialListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RefReport{
+		Issues: []RefIssue{},
+		Scanned: map[Resource]int{
+			ResourceCheck:                   len(checks),
+			ResourceContact:                 len(contacts),
+			ResourceIntegration:             len(integrations),
+			ResourcePushNotificationProfile: len(profiles),
+			ResourceTag:                     len(tags),
+			ResourceDashboard:               len(dashboards),
+			ResourceSLAReport:               len(reports),
+			ResourceStatusPage:              len(pages),
+			ResourceServiceVariable:         len(variables),
+			ResourceCredential:              len(credentials),
+		},
+	}
+	dangling := func(issue RefIssue, refs []string, known map[string]bool) {
+		for _, ref := range refs {
+			if !known[ref] {
+				issue.Problem, issue.Ref = RefDangling, ref
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+
+	checkNames := make(map[string]bool, len(checks))
+	checkPKs := make(map[string]bool, len(checks))
+	for _, c := range checks {
+		checkNames[c.Name] = true
+		checkPKs[strconv.FormatInt(c.PK, 10)] = true
+	}
+	contactNames := make(map[string]bool, len(contacts))
+	for _, c := range contacts {
+		contactNames[c.Name] = true
+	}
+	integrationNames := make(map[string]bool, len(integrations))
+	for _, i := range integrations {
+		integrationNames[i.Name] = true
+	}
+	// Contacts may name a profile by its display name, device name or UUID.
+	profileNames := make(map[string]bool, 3*len(profiles))
+	for _, p := range profiles {
+		profileNames[p.DisplayName] = true
+		profileNames[p.DeviceName] = true
+		profileNames[p.UUID] = true
+	}
+	tagNames := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagNames[t.Tag] = true
+	}
+	credentialPKs := make(map[string]bool, len(credentials))
+	for _, c := range credentials {
+		credentialPKs[strconv.FormatInt(c.PK, 10)] = true
+	}
+
+	usedTags := make(map[string]bool)
+	use := func(names []string) {
+		for _, name := range names {
+			usedTags[name] = true
+		}
+	}
+
+	for _, c := range checks {
+		issue := RefIssue{Resource: ResourceCheck, PK: PrimaryKey(c.PK), Name: c.Name}
+		if c.ContactGroups != nil {
+			issue.Field, issue.Target = "contact_groups", ResourceContact
+			dangling(issue, *c.ContactGroups, contactNames)
+		}
+		issue.Field, issue.Target = "tags", ResourceTag
+		dangling(issue, c.Tags, tagNames)
+		use(c.Tags)
+		if g := c.GroupConfig; g != nil {
+			issue.Field, issue.Target = "group_check_services", ResourceCheck
+			dangling(issue, g.CheckServices, checkNames)
+			issue.Field, issue.Target = "group_check_tags", ResourceTag
+			dangling(issue, g.CheckTags, tagNames)
+			use(g.CheckTags)
+		}
+	}
+	for _, c := range contacts {
+		issue := RefIssue{Resource: ResourceContact, PK: PrimaryKey(c.PK), Name: c.Name}
+		issue.Field, issue.Target = "integrations", ResourceIntegration
+		dangling(issue, c.Integrations, integrationNames)
+		issue.Field, issue.Target = "push_notification_profiles", ResourcePushNotificationProfile
+		dangling(issue, c.PushNotificationProfiles, profileNames)
+	}
+	for _, d := range dashboards {
+		issue := RefIssue{Resource: ResourceDashboard, PK: PrimaryKey(d.PK), Name: d.Name}
+		issue.Field, issue.Target = "services_selected", ResourceCheck
+		dangling(issue, d.ServicesSelected, checkNames)
+		issue.Field, issue.Target = "services_tags", ResourceTag
+		dangling(issue, d.ServicesTags, tagNames)
+		use(d.ServicesTags)
+	}
+	for _, r := range reports {
+		issue := RefIssue{Resource: ResourceSLAReport, PK: PrimaryKey(r.PK), Name: r.Name}
+		if r.ServicesSelected != nil {
+			issue.Field, issue.Target = "services_selected", ResourceCheck
+			for _, s := range *r.ServicesSelected {
+				if s.PK != 0 {
+					dangling(issue, []string{strconv.Itoa(s.PK)}, checkPKs)
+				} else {
+					dangling(issue, []string{s.Name}, checkNames)
+				}
+			}
+		}
+		issue.Field, issue.Target = "services_tags", ResourceTag
+		dangling(issue, r.ServicesTags, tagNames)
+		use(r.ServicesTags)
+	}
+	for _, p := range pages {
+		components, err := ListAll(ctx, api.StatusPages().Components(p), StatusPageComponentListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		metrics, err := ListAll(ctx, api.StatusPages().Metrics(p), StatusPageMetricListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		report.Scanned[ResourceStatusPageComponent] += len(components)
+		report.Scanned[ResourceStatusPageMetric] += len(metrics)
+		for _, c := range components {
+			if c.ServiceID == nil {
+				continue
+			}
+			issue := RefIssue{Resource: ResourceStatusPageComponent, PK: PrimaryKey(c.PK), Name: c.Name, StatusPage: PrimaryKey(p.PK), Field: "service_id", Target: ResourceCheck}
+			dangling(issue, []string{strconv.FormatInt(*c.ServiceID, 10)}, checkPKs)
+		}
+		for _, m := range metrics {
+			if m.ServiceID == 0 {
+				continue
+			}
+			issue := RefIssue{Resource: ResourceStatusPageMetric, PK: PrimaryKey(m.PK), Name: m.Name, StatusPage: PrimaryKey(p.PK), Field: "service_id", Target: ResourceCheck}
+			dangling(issue, []string{strconv.FormatInt(m.ServiceID, 10)}, checkPKs)
+		}
+	}
+	for _, v := range variables {
+		if v.CredentialID == 0 {
+			continue
+		}
+		issue := RefIssue{Resource: ResourceServiceVariable, PK: v.PrimaryKey(), Name: v.VariableName, Field: "credential_id", Target: ResourceCredential}
+		dangling(issue, []string{strconv.FormatInt(v.CredentialID, 10)}, credentialPKs)
+	}
+	for _, t := range tags {
+		if !usedTags[t.Tag] {
+			report.Issues = append(report.Issues, RefIssue{Problem: RefUnused, Resource: ResourceTag, PK: PrimaryKey(t.PK), Name: t.Tag})
+		}
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		a, b := report.Issues[i], report.Issues[j]
+		if a.Problem != b.Problem {
+			return a.Problem < b.Problem
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.PK < b.PK
+	})
+	return report, nil
+}
@@ -0,0 +1,59 @@
+package upapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRefs(t *testing.T) {
+	listings := map[string]string{
+		"/checks/":                    `{"count": 2, "results": [{"pk": 1, "name": "web", "contact_groups": ["Default", "Gone"], "tags": ["prod"]}, {"pk": 2, "name": "group", "groupcheckconfig": {"group_check_services": ["web", "old"]}}]}`,
+		"/contacts/":                  `{"count": 1, "results": [{"pk": 10, "name": "Default", "integrations": ["Slack", "PagerDuty"], "push_notification_profiles": ["phone"]}]}`,
+		"/integrations/":              `{"count": 1, "results": [{"pk": 20, "name": "Slack"}]}`,
+		"/push-notifications/":        `{"count": 1, "results": [{"pk": 30, "display_name": "phone"}]}`,
+		"/check-tags/":                `{"count": 3, "results": [{"pk": 40, "tag": "prod"}, {"pk": 41, "tag": "stale"}, {"pk": 42, "tag": "dash"}]}`,
+		"/dashboards/":                `{"count": 1, "results": [{"pk": 50, "name": "Main", "services_selected": ["web"], "services_tags": ["dash"]}]}`,
+		"/sla-reports/":               `{"count": 1, "results": [{"pk": 60, "name": "Monthly", "services_selected": [1, 3, "web", "api"]}]}`,
+		"/statuspages/":               `{"count": 1, "results": [{"pk": 70, "name": "Public"}]}`,
+		"/statuspages/70/components/": `{"count": 2, "results": [{"pk": 71, "name": "Web", "service_id": 1}, {"pk": 72, "name": "API", "service_id": 3}]}`,
+		"/statuspages/70/metrics/":    `{"count": 1, "results": [{"pk": 73, "name": "Latency", "service_id": 3}]}`,
+		"/servicevariables/":          `{"count": 2, "results": [{"id": 80, "variable_name": "TOKEN", "credential_id": 90}, {"id": 81, "variable_name": "KEY", "credential_id": 91}]}`,
+		"/credentials/":               `{"count": 1, "results": [{"id": 90, "display_name": "vault"}]}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := listings[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path == "/checks/" && r.URL.Query().Get("is_paused") == "true" {
+			body = `{"count": 0, "results": []}`
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	report, err := AuditRefs(context.Background(), api)
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Equal(t, []RefIssue{
+		{Problem: RefDangling, Resource: ResourceSLAReport, PK: 60, Name: "Monthly", Field: "services_selected", Target: ResourceCheck, Ref: "3"},
+		{Problem: RefDangling, Resource: ResourceSLAReport, PK: 60, Name: "Monthly", Field: "services_selected", Target: ResourceCheck, Ref: "api"},
+		{Problem: RefDangling, Resource: ResourceCheck, PK: 1, Name: "web", Field: "contact_groups", Target: ResourceContact, Ref: "Gone"},
+		{Problem: RefDangling, Resource: ResourceCheck, PK: 2, Name: "group", Field: "group_check_services", Target: ResourceCheck, Ref: "old"},
+		{Problem: RefDangling, Resource: ResourceContact, PK: 10, Name: "Default", Field: "integrations", Target: ResourceIntegration, Ref: "PagerDuty"},
+		{Problem: RefDangling, Resource: ResourceServiceVariable, PK: 81, Name: "KEY", Field: "credential_id", Target: ResourceCredential, Ref: "91"},
+		{Problem: RefDangling, Resource: ResourceStatusPageComponent, PK: 72, Name: "API", StatusPage: 70, Field: "service_id", Target: ResourceCheck, Ref: "3"},
+		{Problem: RefDangling, Resource: ResourceStatusPageMetric, PK: 73, Name: "Latency", StatusPage: 70, Field: "service_id", Target: ResourceCheck, Ref: "3"},
+		{Problem: RefUnused, Resource: ResourceTag, PK: 41, Name: "stale"},
+	}, report.Issues)
+	require.Equal(t, 2, report.Scanned[ResourceStatusPageComponent])
+	require.Equal(t, 2, report.Scanned[ResourceCheck])
+}
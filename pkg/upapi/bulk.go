@@ -0,0 +1,209 @@
+package upapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBulkSkipped is the result of operations Bulk did not run because an
+// earlier one failed with WithStopOnError, or because ctx was cancelled.
+var ErrBulkSkipped = errors.New("bulk operation skipped")
+
+// Creator is implemented by every endpoint exposing a Create method, such as
+// an EndpointCreator.
+type Creator[Arg any, Item any] interface {
+	Create(context.Context, Arg) (*Item, error)
+}
+
+// Updater is implemented by every endpoint exposing an Update method, such
+// as an EndpointUpdater.
+type Updater[Arg any, Item any] interface {
+	Update(context.Context, PrimaryKeyable, Arg) (*Item, error)
+}
+
+// Deleter is implemented by every endpoint exposing a Delete method, such as
+// an EndpointDeleter.
+type Deleter interface {
+	Delete(context.Context, PrimaryKeyable) error
+}
+
+// BulkOp is a single operation run by Bulk.
+type BulkOp[Item any] func(context.Context) (*Item, error)
+
+// BulkResult is the outcome of the operation at Index in the slice given to
+// Bulk. Err is usually an *Error for operations the API rejected.
+type BulkResult[Item any] struct {
+	Index int
+	Item  *Item
+	Err   error
+}
+
+// BulkError is returned by Bulk when at least one operation failed or was
+// skipped. errors.Is and errors.As look at every underlying error, so
+// IsValidation(err) reports whether any operation failed validation.
+type BulkError struct {
+	Total  int
+	Failed int
+	Errs   []error
+}
+
+func (e *BulkError) Error() string {
+	return fmt.Sprintf("%d of %d bulk operations failed, first error: %v", e.Failed, e.Total, e.Errs[0])
+}
+
+func (e *BulkError) Unwrap() []error {
+	return e.Errs
+}
+
+// BulkOption tunes the behaviour of Bulk.
+type BulkOption func(*bulkConfig)
+
+type bulkConfig struct {
+	concurrency int
+	stopOnError bool
+	progress    func(done, total int)
+}
+
+// WithBulkConcurrency runs up to n operations in parallel. The default is 4.
+func WithBulkConcurrency(n int) BulkOption {
+	return func(c *bulkConfig) {
+		c.concurrency = n
+	}
+}
+
+// WithStopOnError stops starting new operations after the first failure.
+// Operations already running complete; the rest fail with ErrBulkSkipped.
+func WithStopOnError() BulkOption {
+	return func(c *bulkConfig) {
+		c.stopOnError = true
+	}
+}
+
+// WithProgress calls fn after every finished operation with the number of
+// operations done so far. Calls are serialized.
+func WithProgress(fn func(done, total int)) BulkOption {
+	return func(c *bulkConfig) {
+		c.progress = fn
+	}
+}
+
+// Bulk runs ops with a bounded worker pool and returns one result per
+// operation, in the order of ops. Requests still pass through the client's
+// options, so a client built with WithRateLimiter or WithRetryPolicy keeps
+// the whole batch within the account's rate limit.
+//
+// The error is nil when every operation succeeded and a *BulkError
+// otherwise; the results are complete either way.
+func Bulk[Item any](ctx context.Context, ops []BulkOp[Item], opts ...BulkOption) ([]BulkResult[Item], error) {
+	cfg := bulkConfig{concurrency: 4}
+	for i := range opts {
+		opts[i](&cfg)
+	}
+	if cfg.concurrency < 1 {
+		cfg.concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]BulkResult[Item], len(ops))
+	var (
+		mu     sync.Mutex
+		done   int
+		failed bool
+		wg     sync.WaitGroup
+	)
+	sem := make(chan struct{}, cfg.concurrency)
+	for i := range ops {
+		results[i].Index = i
+		select {
+		case <-ctx.Done():
+			results[i].Err = ErrBulkSkipped
+			continue
+		case sem <- struct{}{}:
+		}
+		mu.Lock()
+		skip := ctx.Err() != nil || (cfg.stopOnError && failed)
+		mu.Unlock()
+		if skip {
+			<-sem
+			results[i].Err = ErrBulkSkipped
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			item, err := ops[i](ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			results[i].Item, results[i].Err = item, err
+			if err != nil {
+				failed = true
+			}
+			done++
+			if cfg.progress != nil {
+				cfg.progress(done, len(ops))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	if len(errs) == 0 {
+		return results, nil
+	}
+	return results, &BulkError{Total: len(ops), Failed: len(errs), Errs: errs}
+}
+
+// CreateOps returns an operation creating each of args through c.
+func CreateOps[Arg any, Item any](c Creator[Arg, Item], args []Arg) []BulkOp[Item] {
+	ops := make([]BulkOp[Item], len(args))
+	for i := range args {
+		arg := args[i]
+		ops[i] = func(ctx context.Context) (*Item, error) {
+			return c.Create(ctx, arg)
+		}
+	}
+	return ops
+}
+
+// UpdateOps returns an operation updating each of pks with the argument at
+// the same index of args through u. Both slices must have the same length.
+func UpdateOps[Arg any, Item any](u Updater[Arg, Item], pks []PrimaryKeyable, args []Arg) []BulkOp[Item] {
+	if len(pks) != len(args) {
+		panic("upapi: UpdateOps called with mismatched slices")
+	}
+	ops := make([]BulkOp[Item], len(pks))
+	for i := range pks {
+		pk, arg := pks[i], args[i]
+		ops[i] = func(ctx context.Context) (*Item, error) {
+			return u.Update(ctx, pk, arg)
+		}
+	}
+	return ops
+}
+
+// DeleteOps returns an operation deleting each of pks through d. The result
+// item of a successful deletion is its primary key.
+func DeleteOps(d Deleter, pks []PrimaryKeyable) []BulkOp[PrimaryKey] {
+	ops := make([]BulkOp[PrimaryKey], len(pks))
+	for i := range pks {
+		pk := pks[i]
+		ops[i] = func(ctx context.Context) (*PrimaryKey, error) {
+			if err := d.Delete(ctx, pk); err != nil {
+				return nil, err
+			}
+			key := pk.PrimaryKey()
+			return &key, nil
+		}
+	}
+	return ops
+}
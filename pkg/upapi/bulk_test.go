@@ -0,0 +1,123 @@
+package upapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulk(t *testing.T) {
+	var inflight, maxInflight atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			m := maxInflight.Load()
+			if n <= m || maxInflight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		var tag Tag
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &tag)
+		if tag.Tag == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"messages": {"errors": true, "error_code": "VALIDATION_ERROR", "error_fields": {"tag": ["Invalid."]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 1, "tag": "` + tag.Tag + `"}}`))
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	args := []Tag{{Tag: "a"}, {Tag: "bad"}, {Tag: "c"}, {Tag: "d"}, {Tag: "e"}, {Tag: "f"}}
+	var progress []int
+	results, err := Bulk(context.Background(), CreateOps[Tag, Tag](api.Tags(), args),
+		WithBulkConcurrency(2),
+		WithProgress(func(done, total int) {
+			require.Equal(t, 6, total)
+			progress = append(progress, done)
+		}),
+	)
+	require.Len(t, results, 6)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, progress)
+	require.LessOrEqual(t, maxInflight.Load(), int32(2))
+
+	var bulkErr *BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	require.Equal(t, 1, bulkErr.Failed)
+	require.True(t, IsValidation(err))
+
+	for i, r := range results {
+		require.Equal(t, i, r.Index)
+		if i == 1 {
+			require.True(t, IsValidation(r.Err))
+			require.Nil(t, r.Item)
+			continue
+		}
+		require.NoError(t, r.Err)
+		require.Equal(t, args[i].Tag, r.Item.Tag)
+	}
+}
+
+func TestBulkStopOnError(t *testing.T) {
+	ctx := context.Background()
+	var calls atomic.Int32
+	ops := make([]BulkOp[PrimaryKey], 5)
+	for i := range ops {
+		ops[i] = func(context.Context) (*PrimaryKey, error) {
+			calls.Add(1)
+			return nil, &Error{Code: "NOT_FOUND"}
+		}
+	}
+
+	results, err := Bulk(ctx, ops, WithBulkConcurrency(1), WithStopOnError())
+	require.True(t, IsNotFound(err))
+	require.ErrorIs(t, err, ErrBulkSkipped)
+	require.Equal(t, int32(1), calls.Load())
+	require.True(t, IsNotFound(results[0].Err))
+	for _, r := range results[1:] {
+		require.ErrorIs(t, r.Err, ErrBulkSkipped)
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		paths = append(paths, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	results, err := Bulk(context.Background(), DeleteOps(api.Contacts(), []PrimaryKeyable{PrimaryKey(3), Contact{PK: 4}}), WithBulkConcurrency(1))
+	require.NoError(t, err)
+	require.Equal(t, PrimaryKey(4), *results[1].Item)
+	require.Equal(t, "/contacts/3/ /contacts/4/", strings.Join(paths, " "))
+}
+
+func TestBulkUpdate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 2, "name": "renamed"}}`))
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	results, err := Bulk(context.Background(), UpdateOps[Contact, Contact](api.Contacts(), []PrimaryKeyable{PrimaryKey(2)}, []Contact{{Name: "renamed"}}))
+	require.NoError(t, err)
+	require.Equal(t, "renamed", results[0].Item.Name)
+}
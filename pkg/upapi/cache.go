@@ -0,0 +1,231 @@
+package upapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheMaxEntries = 1000
+
+// CacheOptions configures WithCache.
+type CacheOptions struct {
+	// TTL maps endpoint templates to the time their GET responses stay fresh.
+	// A template covers both the collection and its items, so "checks"
+	// applies to checks/ and checks/{pk}/. Nested resources are listed
+	// separately, e.g. "checks/locations", "checks/cloudstatus-services" or
+	// "checks/{pk}/stats".
+	TTL map[string]time.Duration
+	// DefaultTTL applies to GET endpoints not listed in TTL. Zero disables
+	// caching for them.
+	DefaultTTL time.Duration
+	// MaxEntries bounds the number of cached responses. Defaults to 1000.
+	MaxEntries int
+}
+
+// WithCache caches successful GET responses in memory.
+//
+// Once an entry is stale it is revalidated with If-None-Match or
+// If-Modified-Since when the server supplied an ETag or Last-Modified
+// header, and reused on 304 Not Modified. Any other request sent through the
+// client drops the cached entries of the resource it targets, so a Create,
+// Update or Delete on checks invalidates every cached checks/... response.
+//
+// Entries are keyed by URL, credentials and subaccount, so a cache is never
+// shared between different identities. Conditional updates such as
+// UpdateIfUnmodified read the resource from the server, past the cache.
+func WithCache(opts CacheOptions) Option {
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = defaultCacheMaxEntries
+	}
+	return func(cbd CBD) (CBD, error) {
+		return &withCacheCBD{
+			CBD:     cbd,
+			opts:    opts,
+			entries: make(map[string]*cacheEntry),
+		}, nil
+	}
+}
+
+// cacheEntry is a cached response. Entries are never modified once stored, so
+// that they can be read without holding the lock of the cache: revalidation
+// replaces them.
+type cacheEntry struct {
+	resource     string
+	status       int
+	header       http.Header
+	body         []byte
+	expires      time.Time
+	etag         string
+	lastModified string
+}
+
+func (e *cacheEntry) response(rq *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       rq,
+	}
+}
+
+type withCacheCBD struct {
+	CBD
+	opts CacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func (c *withCacheCBD) Do(rq *http.Request) (*http.Response, error) {
+	tmpl := EndpointTemplate(RequestEndpoint(rq))
+	resource := strings.SplitN(tmpl, "/", 2)[0]
+
+	if rq.Method != http.MethodGet {
+		rs, err := c.CBD.Do(rq)
+		c.invalidate(resource)
+		return rs, err
+	}
+
+	ttl := c.ttl(tmpl)
+	if ttl <= 0 {
+		return c.CBD.Do(rq)
+	}
+
+	key := cacheKey(rq)
+	var entry *cacheEntry
+	if bypass, _ := rq.Context().Value(cacheBypassCtxKey{}).(bool); !bypass {
+		c.mu.Lock()
+		entry = c.entries[key]
+		c.mu.Unlock()
+	}
+
+	if entry != nil && time.Now().Before(entry.expires) {
+		return entry.response(rq), nil
+	}
+	if entry != nil {
+		rq = rq.Clone(rq.Context())
+		if entry.etag != "" {
+			rq.Header.Set("If-None-Match", entry.etag)
+		}
+		if entry.lastModified != "" {
+			rq.Header.Set("If-Modified-Since", entry.lastModified)
+		}
+	}
+
+	rs, err := c.CBD.Do(rq)
+	if err != nil {
+		return nil, err
+	}
+	if rs.StatusCode == http.StatusNotModified && entry != nil {
+		_, _ = io.Copy(io.Discard, rs.Body)
+		_ = rs.Body.Close()
+		return c.refresh(key, entry, ttl).response(rq), nil
+	}
+	if rs.StatusCode != http.StatusOK {
+		return rs, nil
+	}
+
+	body, err := io.ReadAll(rs.Body)
+	_ = rs.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rs.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.store(key, &cacheEntry{
+		resource:     resource,
+		status:       rs.StatusCode,
+		header:       rs.Header.Clone(),
+		body:         body,
+		expires:      time.Now().Add(ttl),
+		etag:         rs.Header.Get("ETag"),
+		lastModified: rs.Header.Get("Last-Modified"),
+	})
+	return rs, nil
+}
+
+type cacheBypassCtxKey struct{}
+
+// withoutCache returns ctx with the GET requests made with it sent to the
+// server rather than answered from the cache, which still stores their
+// responses.
+func withoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassCtxKey{}, true)
+}
+
+func (c *withCacheCBD) ttl(tmpl string) time.Duration {
+	tmpl = strings.TrimSuffix(tmpl, "/{pk}")
+	if ttl, ok := c.opts.TTL[tmpl]; ok {
+		return ttl
+	}
+	return c.opts.DefaultTTL
+}
+
+func (c *withCacheCBD) store(key string, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.opts.MaxEntries {
+		c.evict()
+	}
+	c.entries[key] = entry
+}
+
+// refresh replaces entry with a copy fresh for ttl, unless it was dropped or
+// replaced meanwhile, and returns the copy.
+func (c *withCacheCBD) refresh(key string, entry *cacheEntry, ttl time.Duration) *cacheEntry {
+	fresh := *entry
+	fresh.expires = time.Now().Add(ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] == entry {
+		c.entries[key] = &fresh
+	}
+	return &fresh
+}
+
+// evict drops expired entries, or the one closest to expiry when none are.
+// Must be called with c.mu held.
+func (c *withCacheCBD) evict() {
+	now := time.Now()
+	var oldest string
+	for k, e := range c.entries {
+		if now.After(e.expires) && e.etag == "" && e.lastModified == "" {
+			delete(c.entries, k)
+			continue
+		}
+		if oldest == "" || e.expires.Before(c.entries[oldest].expires) {
+			oldest = k
+		}
+	}
+	if len(c.entries) >= c.opts.MaxEntries && oldest != "" {
+		delete(c.entries, oldest)
+	}
+}
+
+func (c *withCacheCBD) invalidate(resource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if e.resource == resource {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func cacheKey(rq *http.Request) string {
+	return strings.Join([]string{
+		rq.URL.String(),
+		rq.Header.Get("Authorization"),
+		rq.Header.Get("X-Subaccount"),
+	}, "\x00")
+}
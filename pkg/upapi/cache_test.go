@@ -0,0 +1,198 @@
+package upapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCache(t *testing.T) {
+	ctx := context.Background()
+
+	hits := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits[r.Method+" "+r.URL.Path]++
+		switch {
+		case r.URL.Path == "/api/v1/checks/1/" && r.Method == http.MethodGet:
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = io.WriteString(w, `{"pk": 1, "name": "check"}`)
+		case r.URL.Path == "/api/v1/checks/1/":
+			_, _ = io.WriteString(w, `{"results": {"pk": 1, "name": "renamed"}}`)
+		case r.URL.Path == "/api/v1/check-tags/1/":
+			_, _ = io.WriteString(w, `{"pk": 1, "tag": "prod"}`)
+		case r.URL.Path == "/api/v1/checks/locations/":
+			_, _ = io.WriteString(w, `{"locations": ["US-East"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	newAPI := func(t *testing.T, opts ...Option) API {
+		api, err := New(append([]Option{
+			WithBaseURL(srv.URL + "/api/v1/"),
+			WithCache(CacheOptions{
+				TTL: map[string]time.Duration{
+					"checks":           time.Hour,
+					"checks/locations": time.Hour,
+				},
+			}),
+		}, opts...)...)
+		require.NoError(t, err)
+		return api
+	}
+
+	t.Run("fresh entries are served from cache", func(t *testing.T) {
+		hits = map[string]int{}
+		api := newAPI(t)
+		for i := 0; i < 3; i++ {
+			check, err := api.Checks().Get(ctx, PrimaryKey(1))
+			require.NoError(t, err)
+			require.Equal(t, "check", check.Name)
+			_, err = api.Checks().ListLocations(ctx)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, hits["GET /api/v1/checks/1/"])
+		require.Equal(t, 1, hits["GET /api/v1/checks/locations/"])
+	})
+
+	t.Run("endpoints without ttl are not cached", func(t *testing.T) {
+		hits = map[string]int{}
+		api := newAPI(t)
+		for i := 0; i < 2; i++ {
+			_, err := api.Tags().Get(ctx, PrimaryKey(1))
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, hits["GET /api/v1/check-tags/1/"])
+	})
+
+	t.Run("updates invalidate the resource", func(t *testing.T) {
+		hits = map[string]int{}
+		api := newAPI(t)
+		_, err := api.Checks().Get(ctx, PrimaryKey(1))
+		require.NoError(t, err)
+		_, err = api.Checks().ListLocations(ctx)
+		require.NoError(t, err)
+		_, err = api.Checks().UpdateHTTP(ctx, PrimaryKey(1), CheckHTTP{Name: "renamed"})
+		require.NoError(t, err)
+		_, err = api.Checks().Get(ctx, PrimaryKey(1))
+		require.NoError(t, err)
+		_, err = api.Checks().ListLocations(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, hits["GET /api/v1/checks/1/"])
+		require.Equal(t, 2, hits["GET /api/v1/checks/locations/"])
+	})
+
+	t.Run("subaccounts do not share entries", func(t *testing.T) {
+		hits = map[string]int{}
+		cache := WithCache(CacheOptions{DefaultTTL: time.Hour})
+		cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+		require.NoError(t, err)
+		cbd, err = cache(cbd)
+		require.NoError(t, err)
+		sub, err := WithSubaccount(2)(cbd)
+		require.NoError(t, err)
+
+		_, err = NewChecksEndpoint(cbd).Get(ctx, PrimaryKey(1))
+		require.NoError(t, err)
+		_, err = NewChecksEndpoint(sub).Get(ctx, PrimaryKey(1))
+		require.NoError(t, err)
+		require.Equal(t, 2, hits["GET /api/v1/checks/1/"])
+	})
+}
+
+func TestWithCacheRevalidate(t *testing.T) {
+	ctx := context.Background()
+
+	var full, notModified int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == "Mon, 01 Jan 2024 00:00:00 GMT" {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		_, _ = io.WriteString(w, `{"pk": 7, "name": "contact"}`)
+	}))
+	defer srv.Close()
+
+	api, err := New(
+		WithBaseURL(srv.URL+"/api/v1/"),
+		WithCache(CacheOptions{TTL: map[string]time.Duration{"contacts": time.Nanosecond}}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		contact, err := api.Contacts().Get(ctx, PrimaryKey(7))
+		require.NoError(t, err)
+		require.Equal(t, "contact", contact.Name)
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 1, full)
+	require.Equal(t, 2, notModified)
+}
+
+func TestWithCacheConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, `{"pk": 7, "name": "contact"}`)
+	}))
+	defer srv.Close()
+
+	api, err := New(
+		WithBaseURL(srv.URL+"/api/v1/"),
+		WithCache(CacheOptions{TTL: map[string]time.Duration{"contacts": time.Microsecond}}),
+	)
+	require.NoError(t, err)
+
+	// Stale entries are revalidated by some requests while others read them.
+	var wg sync.WaitGroup
+	names := make(chan string, 8*50)
+	errs := make(chan error, 8*50)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				contact, err := api.Contacts().Get(ctx, PrimaryKey(7))
+				if err != nil {
+					errs <- err
+					return
+				}
+				names <- contact.Name
+			}
+		}()
+	}
+	wg.Wait()
+	close(names)
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for name := range names {
+		require.Equal(t, "contact", name)
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	require.Equal(t, "checks/{pk}/stats", EndpointTemplate("checks/42/stats/"))
+	require.Equal(t, "statuspages/{pk}/components/{pk}", EndpointTemplate("statuspages/1/components/2/"))
+	require.Equal(t, "check-tags", EndpointTemplate("check-tags/"))
+}
@@ -0,0 +1,271 @@
+package upapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrCassetteMiss is returned when a replaying cassette holds no interaction
+// matching the request.
+var ErrCassetteMiss = errors.New("no matching cassette interaction")
+
+// CassetteMode selects how a Cassette treats requests.
+type CassetteMode int
+
+const (
+	// CassetteReplay answers requests from the cassette file only.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests to the server and records them,
+	// replacing any previous recording on Save.
+	CassetteRecord
+	// CassetteAuto replays when the cassette file exists and records
+	// otherwise.
+	CassetteAuto
+)
+
+// Cassette records request/response pairs to a file and replays them, so that
+// tests of code built on API can run offline. Authorization headers, cookies
+// and the fields tagged `redact:"true"` in the request and response models of
+// the endpoint called are scrubbed before anything is written.
+//
+// Requests are matched on method, path, query and JSON body, compared after
+// normalization and scrubbing. Each recorded interaction is replayed once, in
+// recording order, so a sequence of identical requests receives the recorded
+// sequence of responses. A request which matches nothing fails with
+// ErrCassetteMiss, flagging a change in what the code sends.
+type Cassette struct {
+	path string
+	mode CassetteMode
+
+	mu           sync.Mutex
+	interactions []*CassetteInteraction
+	used         []bool
+}
+
+// CassetteInteraction is a recorded request/response pair.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+type CassetteRequest struct {
+	Method   string          `json:"method"`
+	Path     string          `json:"path"`
+	Query    string          `json:"query,omitempty"`
+	Header   http.Header     `json:"header,omitempty"`
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int             `json:"status_code"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyText   string          `json:"body_text,omitempty"`
+}
+
+// NewCassette opens the cassette stored at path. In CassetteReplay mode the
+// file must exist.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{path: path, mode: mode}
+	if mode == CassetteRecord {
+		return c, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == CassetteAuto {
+		c.mode = CassetteRecord
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	c.mode = CassetteReplay
+	c.used = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// Recording reports whether the cassette sends requests to the server.
+func (c *Cassette) Recording() bool {
+	return c.mode == CassetteRecord
+}
+
+// Save writes the recorded interactions to the cassette file. It is a no-op
+// when replaying.
+func (c *Cassette) Save() error {
+	if c.mode != CassetteRecord {
+		return nil
+	}
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// Unused returns the recorded interactions not replayed so far, which
+// usually means the code under test sent fewer requests than when the
+// cassette was recorded.
+func (c *Cassette) Unused() []*CassetteInteraction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out []*CassetteInteraction
+	for i := range c.interactions {
+		if !c.used[i] {
+			out = append(out, c.interactions[i])
+		}
+	}
+	return out
+}
+
+// WithCassette records to or replays from c. Apply it before any other
+// option so it sees requests exactly as they are sent.
+func WithCassette(c *Cassette) Option {
+	return func(cbd CBD) (CBD, error) {
+		return &withCassetteCBD{cbd, c}, nil
+	}
+}
+
+type withCassetteCBD struct {
+	CBD
+	cassette *Cassette
+}
+
+func (w *withCassetteCBD) Do(rq *http.Request) (*http.Response, error) {
+	body, err := requestBody(rq)
+	if err != nil {
+		return nil, err
+	}
+	recorded := newCassetteRequest(rq, body)
+
+	if w.cassette.mode != CassetteRecord {
+		return w.cassette.replay(rq, recorded)
+	}
+
+	rs, err := w.CBD.Do(rq)
+	if err != nil {
+		return nil, err
+	}
+	rsBody, err := io.ReadAll(rs.Body)
+	_ = rs.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rs.Body = io.NopCloser(bytes.NewReader(rsBody))
+
+	interaction := &CassetteInteraction{
+		Request: recorded,
+		Response: CassetteResponse{
+			StatusCode: rs.StatusCode,
+			Header:     redactHeader(rs.Header),
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyText = cassetteBody(redactedKeys(rq.Context()), rsBody)
+
+	w.cassette.mu.Lock()
+	w.cassette.interactions = append(w.cassette.interactions, interaction)
+	w.cassette.used = append(w.cassette.used, true)
+	w.cassette.mu.Unlock()
+	return rs, nil
+}
+
+func (c *Cassette) replay(rq *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, in := range c.interactions {
+		if c.used[i] || !in.Request.matches(recorded) {
+			continue
+		}
+		c.used[i] = true
+		body := []byte(in.Response.BodyText)
+		if len(in.Response.Body) > 0 {
+			body = in.Response.Body
+		}
+		header := in.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       rq,
+		}, nil
+	}
+	desc := recorded.Method + " " + recorded.Path
+	if recorded.Query != "" {
+		desc += "?" + recorded.Query
+	}
+	if len(recorded.Body) > 0 {
+		desc += " " + string(recorded.Body)
+	} else if recorded.BodyText != "" {
+		desc += " " + recorded.BodyText
+	}
+	return nil, fmt.Errorf("%w: %s", ErrCassetteMiss, desc)
+}
+
+func newCassetteRequest(rq *http.Request, body []byte) CassetteRequest {
+	header := redactHeader(rq.Header)
+	for _, k := range []string{"Traceparent", "Tracestate", "Baggage"} {
+		header.Del(k)
+	}
+	r := CassetteRequest{
+		Method: rq.Method,
+		Path:   rq.URL.Path,
+		Query:  rq.URL.Query().Encode(),
+		Header: header,
+	}
+	r.Body, r.BodyText = cassetteBody(redactedKeys(rq.Context()), body)
+	return r
+}
+
+// cassetteBody scrubs the fields named in keys from body and returns it as
+// normalized JSON, or as text when it is not JSON.
+func cassetteBody(keys map[string]bool, body []byte) (json.RawMessage, string) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, ""
+	}
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, string(trimmed)
+	}
+	redactValue(v, keys)
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, string(trimmed)
+	}
+	return json.RawMessage(bytes.TrimSpace(buf.Bytes())), ""
+}
+
+func (r CassetteRequest) matches(o CassetteRequest) bool {
+	if r.Method != o.Method || strings.TrimSuffix(r.Path, "/") != strings.TrimSuffix(o.Path, "/") || r.Query != o.Query {
+		return false
+	}
+	if r.BodyText != o.BodyText {
+		return false
+	}
+	a, _ := cassetteBody(nil, r.Body)
+	b, _ := cassetteBody(nil, o.Body)
+	return bytes.Equal(a, b)
+}
@@ -0,0 +1,95 @@
+package upapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checks.json")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 1, "name": "web", "msp_password": "hunter2"}}`))
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"pk": 1, "name": "web"}`))
+		}
+	}))
+
+	rec, err := NewCassette(path, CassetteAuto)
+	require.NoError(t, err)
+	require.True(t, rec.Recording())
+	api, err := New(WithCassette(rec), WithBaseURL(srv.URL+"/api/v1/"), WithToken("s3cr3t-token"))
+	require.NoError(t, err)
+
+	_, err = api.Checks().CreateHTTP(ctx, CheckHTTP{Name: "web", Address: "https://example.com", Password: "hunter2"})
+	require.NoError(t, err)
+	_, err = api.Checks().Get(ctx, PrimaryKey(1))
+	require.NoError(t, err)
+	require.NoError(t, rec.Save())
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "hunter2")
+	require.NotContains(t, string(data), "s3cr3t-token")
+
+	play, err := NewCassette(path, CassetteAuto)
+	require.NoError(t, err)
+	require.False(t, play.Recording())
+	api, err = New(WithCassette(play), WithBaseURL(srv.URL+"/api/v1/"), WithToken("other-token"))
+	require.NoError(t, err)
+
+	// Secrets are scrubbed on both sides, so a different password still matches.
+	check, err := api.Checks().CreateHTTP(ctx, CheckHTTP{Name: "web", Address: "https://example.com", Password: "changed"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), check.PK)
+	require.Len(t, play.Unused(), 1)
+
+	check, err = api.Checks().Get(ctx, PrimaryKey(1))
+	require.NoError(t, err)
+	require.Equal(t, "web", check.Name)
+	require.Empty(t, play.Unused())
+
+	// Every interaction is replayed once.
+	_, err = api.Checks().Get(ctx, PrimaryKey(1))
+	require.ErrorIs(t, err, ErrCassetteMiss)
+}
+
+func TestCassetteDetectsRequestChange(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tags.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{
+			"request": {"method": "POST", "path": "/api/v1/check-tags/", "body": {"tag": "prod", "color_hex": "#fff"}},
+			"response": {"status_code": 200, "body": {"results": {"pk": 7, "tag": "prod", "color_hex": "#fff"}}}
+		}
+	]`), 0o644))
+
+	c, err := NewCassette(path, CassetteReplay)
+	require.NoError(t, err)
+	api, err := New(WithCassette(c), WithBaseURL("http://127.0.0.1:1/api/v1/"))
+	require.NoError(t, err)
+
+	_, err = api.Tags().Create(ctx, Tag{Tag: "prod", ColorHex: "#000"})
+	require.ErrorIs(t, err, ErrCassetteMiss)
+	require.ErrorContains(t, err, `"color_hex":"#000"`)
+
+	// Key order and whitespace do not matter.
+	tag, err := api.Tags().Create(ctx, Tag{ColorHex: "#fff", Tag: "prod"})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), tag.PK)
+}
+
+func TestCassetteReplayMissingFile(t *testing.T) {
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -6,6 +6,9 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -42,7 +45,9 @@ func (r *requestBuilderImpl) BuildRequest(ctx context.Context, method string, en
 			return nil, err
 		}
 		body = bytes.NewReader(buf.Bytes())
+		ctx = withRedacted(ctx, reflect.TypeOf(data))
 	}
+	ctx = context.WithValue(ctx, endpointCtxKey{}, endpoint)
 	rq, err := http.NewRequestWithContext(ctx, method, endpoint, body)
 	if err != nil {
 		return nil, err
@@ -58,6 +63,28 @@ func (r *requestBuilderImpl) BuildRequest(ctx context.Context, method string, en
 	return rq, nil
 }
 
+type endpointCtxKey struct{}
+
+// RequestEndpoint returns the endpoint, relative to the base URL, the request
+// was built for, e.g. "checks/42/stats/".
+func RequestEndpoint(rq *http.Request) string {
+	endpoint, _ := rq.Context().Value(endpointCtxKey{}).(string)
+	return endpoint
+}
+
+// EndpointTemplate replaces primary keys in endpoint with a {pk} placeholder
+// and strips surrounding slashes, e.g. "checks/42/stats/" becomes
+// "checks/{pk}/stats".
+func EndpointTemplate(endpoint string) string {
+	parts := strings.Split(strings.Trim(endpoint, "/"), "/")
+	for i := range parts {
+		if _, err := strconv.ParseInt(parts[i], 10, 64); err == nil {
+			parts[i] = "{pk}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
 type responseDecoderImpl struct{}
 
 func (r *responseDecoderImpl) DecodeResponse(rs *http.Response, data any) error {
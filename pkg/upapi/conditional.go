@@ -0,0 +1,152 @@
+package upapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrConflict is matched by *ConflictError through errors.Is.
+var ErrConflict = errors.New("resource was modified")
+
+// IsConflict reports whether err is a conditional update rejected because the
+// resource changed in the meantime.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// ConflictError is returned by conditional updates when the resource was
+// modified after the time the caller based its changes on.
+type ConflictError struct {
+	PK         PrimaryKey
+	Since      time.Time
+	ModifiedAt time.Time
+	// Current is the resource as read before the update was abandoned, e.g. a
+	// *Check.
+	Current any
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("resource %d was modified at %s, after %s", e.PK, e.ModifiedAt.Format(time.RFC3339), e.Since.Format(time.RFC3339))
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ErrUnknownModification is matched by *UnknownModificationError through
+// errors.Is.
+var ErrUnknownModification = errors.New("resource modification time is unknown")
+
+// UnknownModificationError is returned by conditional updates when the
+// resource does not tell when it was last modified, or tells it in a format
+// which cannot be parsed. The update is not made, since it could overwrite
+// changes it cannot detect.
+type UnknownModificationError struct {
+	PK PrimaryKey
+	// Err is the error parsing the modification time, if any.
+	Err error
+}
+
+func (e *UnknownModificationError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("resource %d modification time is unknown: %s", e.PK, e.Err)
+	}
+	return fmt.Sprintf("resource %d modification time is unknown", e.PK)
+}
+
+func (e *UnknownModificationError) Is(target error) bool {
+	return target == ErrUnknownModification
+}
+
+func (e *UnknownModificationError) Unwrap() error {
+	return e.Err
+}
+
+// Modifiable is implemented by resources carrying a modification timestamp.
+// LastModified returns the zero time when the resource has none.
+type Modifiable interface {
+	LastModified() (time.Time, error)
+}
+
+// Getter is implemented by every endpoint exposing a Get method, such as an
+// EndpointGetter.
+type Getter[Item any] interface {
+	Get(context.Context, PrimaryKeyable) (*Item, error)
+}
+
+// MergeFunc reconciles arg with current, the version of the resource which
+// was modified concurrently, and returns the argument to update it with.
+type MergeFunc[Arg any, Item any] func(current *Item, arg Arg) (Arg, error)
+
+// maxMergeAttempts bounds how many times a conditional update is retried
+// through its MergeFunc when the resource keeps changing.
+const maxMergeAttempts = 3
+
+// UpdateIfUnmodified updates the resource pk with arg only if it was not
+// modified after since, typically the modification time of the copy arg is
+// derived from. Otherwise it calls merge, when not nil, and retries with the
+// merged argument, or returns a *ConflictError. It returns an
+// *UnknownModificationError, without updating anything, when the resource has
+// no modification time to compare with since.
+//
+// The resource is read from the server even with WithCache, since a cached
+// copy could hide a concurrent change. The check and the update are separate
+// requests, so this narrows the window in which concurrent writers overwrite
+// each other rather than closing it.
+func UpdateIfUnmodified[Arg any, Item Modifiable](ctx context.Context, g Getter[Item], u Updater[Arg, Item], pk PrimaryKeyable, since time.Time, arg Arg, merge MergeFunc[Arg, Item]) (*Item, error) {
+	for attempt := 1; ; attempt++ {
+		current, err := g.Get(withoutCache(ctx), pk)
+		if err != nil {
+			return nil, err
+		}
+		modified, err := (*current).LastModified()
+		if err != nil || modified.IsZero() {
+			return nil, &UnknownModificationError{PK: pk.PrimaryKey(), Err: err}
+		}
+		if !modified.After(since) {
+			return u.Update(ctx, pk, arg)
+		}
+		if merge == nil || attempt > maxMergeAttempts {
+			return nil, &ConflictError{
+				PK:         pk.PrimaryKey(),
+				Since:      since,
+				ModifiedAt: modified,
+				Current:    current,
+			}
+		}
+		if arg, err = merge(current, arg); err != nil {
+			return nil, err
+		}
+		since = modified
+	}
+}
+
+func (c Check) LastModified() (time.Time, error) {
+	return c.ModifiedAt, nil
+}
+
+func (p PushNotificationProfile) LastModified() (time.Time, error) {
+	if p.ModifiedAt == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, p.ModifiedAt)
+}
+
+// checksSaveUpdater adapts Save to the Updater interface.
+type checksSaveUpdater struct {
+	checksEndpointSaveImpl
+}
+
+func (c checksSaveUpdater) Update(ctx context.Context, pk PrimaryKeyable, check CheckRequest) (*Check, error) {
+	return c.Save(ctx, check, CheckSaveOptions{PK: pk})
+}
+
+func (c *checksEndpointImpl) UpdateIfUnmodified(ctx context.Context, pk PrimaryKeyable, since time.Time, check CheckRequest, merge MergeFunc[CheckRequest, Check]) (*Check, error) {
+	return UpdateIfUnmodified[CheckRequest, Check](ctx, c.EndpointGetter, checksSaveUpdater{c.checksEndpointSaveImpl}, pk, since, check, merge)
+}
+
+func (p *pushNotificationsEndpointImpl) UpdateIfUnmodified(ctx context.Context, pk PrimaryKeyable, since time.Time, rq PushNotificationProfileUpdateRequest, merge MergeFunc[PushNotificationProfileUpdateRequest, PushNotificationProfile]) (*PushNotificationProfile, error) {
+	return UpdateIfUnmodified[PushNotificationProfileUpdateRequest, PushNotificationProfile](ctx, p.EndpointGetter, p.EndpointUpdater, pk, since, rq, merge)
+}
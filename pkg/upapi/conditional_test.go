@@ -0,0 +1,145 @@
+package upapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksUpdateIfUnmodified(t *testing.T) {
+	ctx := context.Background()
+	modifiedAt := "2024-01-01T10:00:00Z"
+	var patches int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"pk": 1, "name": "web", "check_type": "HTTP", "msp_interval": 5, "modified_at": "` + modifiedAt + `"}`))
+		case http.MethodPatch:
+			patches++
+			_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 1, "name": "web"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = api.Checks().UpdateIfUnmodified(ctx, PrimaryKey(1), since, CheckHTTP{Name: "web"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, patches)
+
+	modifiedAt = "2024-01-01T11:00:00Z"
+	_, err = api.Checks().UpdateIfUnmodified(ctx, PrimaryKey(1), since, CheckHTTP{Name: "web"}, nil)
+	require.True(t, IsConflict(err))
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, PrimaryKey(1), conflict.PK)
+	require.Equal(t, int64(5), conflict.Current.(*Check).Interval)
+	require.Equal(t, 1, patches)
+
+	var merged CheckHTTP
+	_, err = api.Checks().UpdateIfUnmodified(ctx, PrimaryKey(1), since, CheckHTTP{Name: "web", Address: "https://example.com"},
+		func(current *Check, rq CheckRequest) (CheckRequest, error) {
+			merged = current.AsHTTP()
+			merged.Address = rq.(CheckHTTP).Address
+			return merged, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), merged.Interval)
+	require.Equal(t, 2, patches)
+}
+
+func TestUpdateIfUnmodifiedWithCache(t *testing.T) {
+	ctx := context.Background()
+	modifiedAt := "2024-01-01T10:00:00Z"
+	var patches int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"pk": 1, "name": "web", "check_type": "HTTP", "modified_at": "` + modifiedAt + `"}`))
+		case http.MethodPatch:
+			patches++
+			_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 1, "name": "web"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL+"/"), WithCache(CacheOptions{DefaultTTL: time.Hour}))
+	require.NoError(t, err)
+
+	check, err := api.Checks().Get(ctx, PrimaryKey(1))
+	require.NoError(t, err)
+
+	// Another client modifies the check, which the cache cannot know of.
+	modifiedAt = "2024-01-01T11:00:00Z"
+	_, err = api.Checks().UpdateIfUnmodified(ctx, PrimaryKey(1), check.ModifiedAt, CheckHTTP{Name: "web"}, nil)
+	require.True(t, IsConflict(err))
+	require.Equal(t, 0, patches)
+
+	// The fresh copy read past the cache is cached in turn.
+	check, err = api.Checks().Get(ctx, PrimaryKey(1))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC), check.ModifiedAt)
+}
+
+func TestPushNotificationsUpdateIfUnmodified(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		_, _ = w.Write([]byte(`{"pk": 2, "device_name": "phone", "modified_at": "2024-01-01T10:00:00.123456Z"}`))
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	_, err = api.PushNotifications().UpdateIfUnmodified(ctx, PrimaryKey(2), time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		PushNotificationProfileUpdateRequest{DeviceName: "tablet"}, nil)
+	require.True(t, IsConflict(err))
+}
+
+func TestUpdateIfUnmodifiedUnknownModification(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		body  string
+		check bool
+		parse bool
+	}{
+		"check without modified_at":         {body: `{"pk": 1, "name": "web", "check_type": "HTTP"}`, check: true},
+		"profile without modified_at":       {body: `{"pk": 1, "device_name": "phone"}`},
+		"profile with unparsable timestamp": {body: `{"pk": 1, "device_name": "phone", "modified_at": "01/01/2024 10:00"}`, parse: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				_, _ = w.Write([]byte(c.body))
+			}))
+			defer ts.Close()
+
+			api, err := New(WithBaseURL(ts.URL + "/"))
+			require.NoError(t, err)
+
+			if c.check {
+				_, err = api.Checks().UpdateIfUnmodified(ctx, PrimaryKey(1), since, CheckHTTP{Name: "web"}, nil)
+			} else {
+				_, err = api.PushNotifications().UpdateIfUnmodified(ctx, PrimaryKey(1), since,
+					PushNotificationProfileUpdateRequest{DeviceName: "tablet"}, nil)
+			}
+			require.ErrorIs(t, err, ErrUnknownModification)
+			require.False(t, IsConflict(err))
+			var unknown *UnknownModificationError
+			require.ErrorAs(t, err, &unknown)
+			require.Equal(t, PrimaryKey(1), unknown.PK)
+			var perr *time.ParseError
+			require.Equal(t, c.parse, errors.As(err, &perr))
+		})
+	}
+}
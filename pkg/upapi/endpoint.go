@@ -46,6 +46,7 @@ type endpointGetterImpl[ResponseType Itemable[ItemType], ItemType any] struct {
 }
 
 func (p *endpointGetterImpl[ResponseType, ItemType]) Get(ctx context.Context, pk PrimaryKeyable) (*ItemType, error) {
+	ctx = withRedacted(ctx, typeOf[ResponseType]())
 	rq, err := p.BuildRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d/", p.endpoint, pk.PrimaryKey()), nil, nil)
 	if err != nil {
 		return nil, err
@@ -82,6 +83,7 @@ type endpointListerImpl[ResponseType Listable[ItemType], ItemType any, OptionsTy
 }
 
 func (p *endpointListerImpl[ResponseType, ItemType, OptionsType]) List(ctx context.Context, opts OptionsType) (*ListResult[ItemType], error) {
+	ctx = withRedacted(ctx, typeOf[ResponseType]())
 	rq, err := p.BuildRequest(ctx, http.MethodGet, fmt.Sprintf("%s/", p.endpoint), opts, nil)
 	if err != nil {
 		return nil, err
@@ -120,6 +122,7 @@ type endpointCreatorImpl[RequestType any, ResponseType Itemable[ItemType], ItemT
 }
 
 func (p *endpointCreatorImpl[RequestType, ResponseType, ItemType]) Create(ctx context.Context, arg RequestType) (*ItemType, error) {
+	ctx = withRedacted(ctx, typeOf[RequestType](), typeOf[ResponseType]())
 	rq, err := p.BuildRequest(ctx, http.MethodPost, fmt.Sprintf("%s/", p.endpoint), nil, arg)
 	if err != nil {
 		return nil, err
@@ -156,6 +159,7 @@ type endpointUpdaterImpl[RequestType any, ResponseType Itemable[ItemType], ItemT
 }
 
 func (p *endpointUpdaterImpl[RequestType, ResponseType, ItemType]) Update(ctx context.Context, pk PrimaryKeyable, arg RequestType) (*ItemType, error) {
+	ctx = withRedacted(ctx, typeOf[RequestType](), typeOf[ResponseType]())
 	rq, err := p.BuildRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%d/", p.endpoint, pk.PrimaryKey()), nil, arg)
 	if err != nil {
 		return nil, err
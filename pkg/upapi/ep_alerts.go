@@ -112,6 +112,7 @@ type alertsEndpointImpl struct {
 // RootCause retrieves the root cause analysis data for a specific alert.
 func (e *alertsEndpointImpl) RootCause(ctx context.Context, pk PrimaryKeyable) (*AlertRootCause, error) {
 	path := fmt.Sprintf("%s/alert/%d/root-cause/", e.endpoint, pk.PrimaryKey())
+	ctx = withRedacted(ctx, typeOf[AlertRootCause]())
 	req, err := e.cbd.BuildRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
@@ -134,6 +135,7 @@ func (e *alertsEndpointImpl) RootCause(ctx context.Context, pk PrimaryKeyable) (
 // Ignore toggles the ignore state of an alert.
 func (e *alertsEndpointImpl) Ignore(ctx context.Context, pk PrimaryKeyable) (*AlertItem, error) {
 	path := fmt.Sprintf("%s/%d/ignore/", e.endpoint, pk.PrimaryKey())
+	ctx = withRedacted(ctx, typeOf[AlertResponse]())
 	req, err := e.cbd.BuildRequest(ctx, "POST", path, nil, nil)
 	if err != nil {
 		return nil, err
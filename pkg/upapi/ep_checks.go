@@ -43,51 +43,52 @@ type CheckGroupConfig struct {
 
 // Check represents a check in Uptime.com.
 type Check struct {
-	PK                     int64             `json:"pk,omitempty"`
-	URL                    string            `json:"url,omitempty"`
-	StatsURL               string            `json:"stats_url,omitempty"`
-	AlertsURL              string            `json:"alerts_url,omitempty"`
-	Name                   string            `json:"name,omitempty"`
-	CachedResponseTime     float64           `json:"cached_response_time,omitempty"`
-	ContactGroups          *[]string         `json:"contact_groups,omitempty"`
-	CreatedAt              time.Time         `json:"created_at,omitempty"`
-	ModifiedAt             time.Time         `json:"modified_at,omitempty"`
-	Locations              []string          `json:"locations,omitempty"`
-	Tags                   []string          `json:"tags,omitempty"`
-	CheckType              string            `json:"check_type,omitempty"`
-	Escalations            []CheckEscalation `json:"escalations,omitempty"`
-	MonitoringServiceType  string            `json:"monitoring_service_type,omitempty"`
-	IsPaused               bool              `json:"is_paused,omitempty"`
-	IsUnderMaintenance     bool              `json:"is_under_maintenance,omitempty"`
-	StateIsUp              bool              `json:"state_is_up,omitempty"`
-	StateChangedAt         time.Time         `json:"state_changed_at,omitempty"`
-	HeartbeatURL           string            `json:"heartbeat_url,omitempty"`
-	WebhookURL             string            `json:"webhook_url,omitempty"`
-	Protocol               string            `json:"msp_protocol,omitempty"`
-	Interval               int64             `json:"msp_interval,omitempty"`
-	Address                string            `json:"msp_address,omitempty"`
-	Port                   int64             `json:"msp_port,omitempty"`
-	Username               string            `json:"msp_username,omitempty"`
-	Password               string            `json:"msp_password,omitempty"`
-	Proxy                  string            `json:"msp_proxy,omitempty"`
-	DNSServer              string            `json:"msp_dns_server,omitempty"`
-	DNSRecordType          string            `json:"msp_dns_record_type,omitempty"`
-	StatusCode             string            `json:"msp_status_code,omitempty"`
-	SendString             string            `json:"msp_send_string,omitempty"`
-	ExpectString           string            `json:"msp_expect_string,omitempty"`
-	ExpectStringType       string            `json:"msp_expect_string_type,omitempty"`
-	Encryption             *string           `json:"msp_encryption,omitempty"`
-	Threshold              int64             `json:"msp_threshold,omitempty"`
-	Headers                string            `json:"msp_headers,omitempty"`
-	Script                 string            `json:"msp_script,omitempty"`
-	Version                int64             `json:"msp_version,omitempty"`
-	Sensitivity            int64             `json:"msp_sensitivity,omitempty"`
-	NumRetries             int64             `json:"msp_num_retries,omitempty"`
-	UseIPVersion           string            `json:"msp_use_ip_version,omitempty"`
-	UptimeSLA              decimal.Decimal   `json:"msp_uptime_sla,omitempty"`
-	ResponseTimeSLA        decimal.Decimal   `json:"msp_response_time_sla,omitempty"`
-	Notes                  string            `json:"msp_notes,omitempty"`
-	IncludeInGlobalMetrics bool              `json:"msp_include_in_global_metrics,omitempty"`
+	PK                        int64             `json:"pk,omitempty"`
+	URL                       string            `json:"url,omitempty"`
+	StatsURL                  string            `json:"stats_url,omitempty"`
+	AlertsURL                 string            `json:"alerts_url,omitempty"`
+	Name                      string            `json:"name,omitempty"`
+	CachedResponseTime        float64           `json:"cached_response_time,omitempty"`
+	ContactGroups             *[]string         `json:"contact_groups,omitempty"`
+	CreatedAt                 time.Time         `json:"created_at,omitempty"`
+	ModifiedAt                time.Time         `json:"modified_at,omitempty"`
+	Locations                 []string          `json:"locations,omitempty"`
+	Tags                      []string          `json:"tags,omitempty"`
+	CheckType                 string            `json:"check_type,omitempty"`
+	Escalations               []CheckEscalation `json:"escalations,omitempty"`
+	MonitoringServiceType     string            `json:"monitoring_service_type,omitempty"`
+	IsPaused                  bool              `json:"is_paused,omitempty"`
+	IsUnderMaintenance        bool              `json:"is_under_maintenance,omitempty"`
+	StateIsUp                 bool              `json:"state_is_up,omitempty"`
+	StateChangedAt            time.Time         `json:"state_changed_at,omitempty"`
+	HeartbeatURL              string            `json:"heartbeat_url,omitempty"`
+	WebhookURL                string            `json:"webhook_url,omitempty"`
+	Protocol                  string            `json:"msp_protocol,omitempty"`
+	Interval                  int64             `json:"msp_interval,omitempty"`
+	Address                   string            `json:"msp_address,omitempty"`
+	Port                      int64             `json:"msp_port,omitempty"`
+	Username                  string            `json:"msp_username,omitempty"`
+	Password                  string            `json:"msp_password,omitempty" redact:"true"`
+	Proxy                     string            `json:"msp_proxy,omitempty"`
+	DNSServer                 string            `json:"msp_dns_server,omitempty"`
+	DNSRecordType             string            `json:"msp_dns_record_type,omitempty"`
+	StatusCode                string            `json:"msp_status_code,omitempty"`
+	SendString                string            `json:"msp_send_string,omitempty"`
+	ExpectString              string            `json:"msp_expect_string,omitempty"`
+	ExpectStringType          string            `json:"msp_expect_string_type,omitempty"`
+	Encryption                *string           `json:"msp_encryption,omitempty"`
+	Threshold                 int64             `json:"msp_threshold,omitempty"`
+	Headers                   string            `json:"msp_headers,omitempty"`
+	Script                    string            `json:"msp_script,omitempty"`
+	Version                   int64             `json:"msp_version,omitempty"`
+	Sensitivity               int64             `json:"msp_sensitivity,omitempty"`
+	NumRetries                int64             `json:"msp_num_retries,omitempty"`
+	UseIPVersion              string            `json:"msp_use_ip_version,omitempty"`
+	UptimeSLA                 decimal.Decimal   `json:"msp_uptime_sla,omitempty"`
+	ResponseTimeSLA           decimal.Decimal   `json:"msp_response_time_sla,omitempty"`
+	Notes                     string            `json:"msp_notes,omitempty"`
+	IncludeInGlobalMetrics    bool              `json:"msp_include_in_global_metrics,omitempty"`
+	SendResolvedNotifications *bool             `json:"msp_send_resolved_notifications,omitempty"`
 
 	Maintenance *CheckMaintenance `json:"maintenance,omitempty"`
 
@@ -121,6 +122,8 @@ func (r CheckListResponse) CountItems() int64 {
 }
 
 // CheckListOptions specifies the optional parameters to the CheckService.List method.
+// IsPaused is always sent, so a listing returns either the paused or the unpaused
+// checks; use ListAllChecks to get both.
 type CheckListOptions struct {
 	Page                  int64    `url:"page,omitempty"`
 	PageSize              int64    `url:"page_size,omitempty"`
@@ -132,6 +135,21 @@ type CheckListOptions struct {
 	Tag                   []string `url:"tag,omitempty"`
 }
 
+// ListAllChecks walks every page of l for the checks matching opts, paused or
+// not, ignoring opts.IsPaused.
+func ListAllChecks(ctx context.Context, l Lister[Check, CheckListOptions], opts CheckListOptions, iopts ...IterateOption) ([]Check, error) {
+	var checks []Check
+	for _, paused := range []bool{false, true} {
+		opts.IsPaused = paused
+		items, err := ListAll(ctx, l, opts, iopts...)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, items...)
+	}
+	return checks, nil
+}
+
 type CheckCreateUpdateResponse struct {
 	Messages map[string]interface{} `json:"messages,omitempty"`
 	Results  Check                  `json:"results,omitempty"`
@@ -199,9 +217,9 @@ type CheckMaintenance struct {
 }
 
 type CheckEscalation struct {
-	WaitTime      int       `json:"wait_time"`
-	NumRepeats    int       `json:"num_repeats"`
-	ContactGroups *[]string `json:"contact_groups,omitempty"`
+	WaitTime      int       `json:"wait_time" yaml:"wait_time"`
+	NumRepeats    int       `json:"num_repeats" yaml:"num_repeats"`
+	ContactGroups *[]string `json:"contact_groups,omitempty" yaml:"contact_groups,omitempty"`
 }
 
 type CheckEscalations struct {
@@ -215,6 +233,9 @@ type ChecksEndpoint interface {
 	Stats(context.Context, PrimaryKeyable, CheckStatsOptions) (*ListResult[CheckStats], error)
 	ListLocations(context.Context) (*ListResult[string], error)
 
+	Save(context.Context, CheckRequest, CheckSaveOptions) (*Check, error)
+	UpdateIfUnmodified(context.Context, PrimaryKeyable, time.Time, CheckRequest, MergeFunc[CheckRequest, Check]) (*Check, error)
+
 	CreateAPI(context.Context, CheckAPI) (*Check, error)
 	UpdateAPI(context.Context, PrimaryKeyable, CheckAPI) (*Check, error)
 
@@ -418,9 +439,10 @@ func NewChecksEndpoint(cbd CBD) ChecksEndpoint {
 				&checksNestedEndpointCBD{CBD: cbd, EndpointSuffix: "escalations/"}, endpoint,
 			),
 		},
-		EndpointLister:  NewEndpointLister[CheckListResponse, Check, CheckListOptions](cbd, endpoint),
-		EndpointGetter:  NewEndpointGetter[CheckGetResponse, Check](cbd, endpoint),
-		EndpointDeleter: NewEndpointDeleter(cbd, endpoint),
+		checksEndpointSaveImpl: checksEndpointSaveImpl{cbd: cbd},
+		EndpointLister:         NewEndpointLister[CheckListResponse, Check, CheckListOptions](cbd, endpoint),
+		EndpointGetter:         NewEndpointGetter[CheckGetResponse, Check](cbd, endpoint),
+		EndpointDeleter:        NewEndpointDeleter(cbd, endpoint),
 	}
 }
 
@@ -455,6 +477,7 @@ type checksEndpointImpl struct {
 	checksEndpointMaintenanceImpl
 	checksEndpointLocationsImpl
 	checksEndpointEscalationsImpl
+	checksEndpointSaveImpl
 	EndpointLister[CheckListResponse, Check, CheckListOptions]
 	EndpointGetter[CheckCreateUpdateResponse, Check]
 	EndpointUpdater[Check, CheckCreateUpdateResponse, Check]
@@ -468,7 +491,7 @@ type checksStatsEndpointImpl struct {
 }
 
 func (c *checksEndpointImpl) Stats(ctx context.Context, pk PrimaryKeyable, opts CheckStatsOptions) (*ListResult[CheckStats], error) {
-	ctx = context.WithValue(ctx, checksPKCtxKey{}, pk)
+	ctx = context.WithValue(ctx, checksPKCtxKey{}, pk.PrimaryKey())
 	return c.endpoint.List(ctx, opts)
 }
 
@@ -0,0 +1,542 @@
+package upapi
+
+import "fmt"
+
+// ToRequest converts c into the request struct matching its CheckType, e.g.
+// CheckHTTP for an HTTP check, so that a fetched check can be modified and
+// passed back to Save or the corresponding Update method. The returned value
+// is not a pointer.
+func (c Check) ToRequest() (CheckRequest, error) {
+	switch c.CheckType {
+	case "API":
+		return c.AsAPI(), nil
+	case "BLACKLIST":
+		return c.AsBlacklist(), nil
+	case "CLOUDSTATUS":
+		return c.AsCloudStatus(), nil
+	case "DNS":
+		return c.AsDNS(), nil
+	case "GROUP":
+		return c.AsGroup(), nil
+	case "HEARTBEAT":
+		return c.AsHeartbeat(), nil
+	case "HTTP":
+		return c.AsHTTP(), nil
+	case "ICMP":
+		return c.AsICMP(), nil
+	case "IMAP":
+		return c.AsIMAP(), nil
+	case "MALWARE":
+		return c.AsMalware(), nil
+	case "NTP":
+		return c.AsNTP(), nil
+	case "PAGESPEED":
+		return c.AsPageSpeed(), nil
+	case "POP":
+		return c.AsPOP(), nil
+	case "RDAP":
+		return c.AsRDAP(), nil
+	case "RUM":
+		return c.AsRUM(), nil
+	case "RUM2":
+		return c.AsRUM2(), nil
+	case "SMTP":
+		return c.AsSMTP(), nil
+	case "SSH":
+		return c.AsSSH(), nil
+	case "SSL_CERT":
+		return c.AsSSLCert(), nil
+	case "TCP":
+		return c.AsTCP(), nil
+	case "TRANSACTION":
+		return c.AsTransaction(), nil
+	case "UDP":
+		return c.AsUDP(), nil
+	case "WEBHOOK":
+		return c.AsWebhook(), nil
+	case "WHOIS":
+		return c.AsWHOIS(), nil
+	}
+	return nil, fmt.Errorf("unsupported check type %q", c.CheckType)
+}
+
+// AsAPI converts c into a CheckAPI request.
+func (c Check) AsAPI() CheckAPI {
+	return CheckAPI{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Threshold:              c.Threshold,
+		Script:                 c.Script,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsBlacklist converts c into a CheckBlacklist request.
+func (c Check) AsBlacklist() CheckBlacklist {
+	return CheckBlacklist{
+		Name:          c.Name,
+		ContactGroups: c.ContactGroups,
+		Locations:     c.Locations,
+		Tags:          c.Tags,
+		IsPaused:      BoolPtr(c.IsPaused),
+		Address:       c.Address,
+		NumRetries:    c.NumRetries,
+		UptimeSLA:     c.UptimeSLA,
+		Notes:         c.Notes,
+	}
+}
+
+// AsCloudStatus converts c into a CheckCloudStatus request.
+func (c Check) AsCloudStatus() CheckCloudStatus {
+	return CheckCloudStatus{
+		Name:              c.Name,
+		ContactGroups:     c.ContactGroups,
+		Locations:         c.Locations,
+		Tags:              c.Tags,
+		IsPaused:          BoolPtr(c.IsPaused),
+		CloudStatusConfig: deref(c.CloudStatusConfig),
+	}
+}
+
+// AsDNS converts c into a CheckDNS request.
+func (c Check) AsDNS() CheckDNS {
+	return CheckDNS{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		DNSServer:              c.DNSServer,
+		DNSRecordType:          c.DNSRecordType,
+		ExpectString:           c.ExpectString,
+		Threshold:              c.Threshold,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsGroup converts c into a CheckGroup request.
+func (c Check) AsGroup() CheckGroup {
+	return CheckGroup{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+		Config:                 deref(c.GroupConfig),
+	}
+}
+
+// AsHeartbeat converts c into a CheckHeartbeat request.
+func (c Check) AsHeartbeat() CheckHeartbeat {
+	return CheckHeartbeat{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+		HeartbeatURL:           c.HeartbeatURL,
+	}
+}
+
+// AsHTTP converts c into a CheckHTTP request.
+func (c Check) AsHTTP() CheckHTTP {
+	return CheckHTTP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		Username:               c.Username,
+		Password:               c.Password,
+		Proxy:                  c.Proxy,
+		StatusCode:             c.StatusCode,
+		SendString:             c.SendString,
+		ExpectString:           c.ExpectString,
+		ExpectStringType:       c.ExpectStringType,
+		Encryption:             c.Encryption,
+		Threshold:              c.Threshold,
+		Headers:                c.Headers,
+		Version:                c.Version,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsICMP converts c into a CheckICMP request.
+func (c Check) AsICMP() CheckICMP {
+	return CheckICMP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsIMAP converts c into a CheckIMAP request.
+func (c Check) AsIMAP() CheckIMAP {
+	return CheckIMAP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		ExpectString:           c.ExpectString,
+		Encryption:             c.Encryption,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsMalware converts c into a CheckMalware request.
+func (c Check) AsMalware() CheckMalware {
+	return CheckMalware{
+		Name:          c.Name,
+		ContactGroups: c.ContactGroups,
+		Locations:     c.Locations,
+		Tags:          c.Tags,
+		IsPaused:      BoolPtr(c.IsPaused),
+		Address:       c.Address,
+		NumRetries:    c.NumRetries,
+		UptimeSLA:     c.UptimeSLA,
+		Notes:         c.Notes,
+	}
+}
+
+// AsNTP converts c into a CheckNTP request.
+func (c Check) AsNTP() CheckNTP {
+	return CheckNTP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		Threshold:              c.Threshold,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsPageSpeed converts c into a CheckPageSpeed request.
+func (c Check) AsPageSpeed() CheckPageSpeed {
+	return CheckPageSpeed{
+		Name:          c.Name,
+		ContactGroups: c.ContactGroups,
+		Locations:     c.Locations,
+		Tags:          c.Tags,
+		IsPaused:      BoolPtr(c.IsPaused),
+		Address:       c.Address,
+		Interval:      c.Interval,
+		Username:      c.Username,
+		Password:      c.Password,
+		Headers:       c.Headers,
+		Script:        c.Script,
+		NumRetries:    c.NumRetries,
+		Notes:         c.Notes,
+		Config:        deref(c.PageSpeedConfig),
+	}
+}
+
+// AsPOP converts c into a CheckPOP request.
+func (c Check) AsPOP() CheckPOP {
+	return CheckPOP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		ExpectString:           c.ExpectString,
+		Encryption:             c.Encryption,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIPVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsRDAP converts c into a CheckRDAP request.
+func (c Check) AsRDAP() CheckRDAP {
+	return CheckRDAP{
+		Name:                      c.Name,
+		ContactGroups:             c.ContactGroups,
+		Locations:                 c.Locations,
+		Tags:                      c.Tags,
+		IsPaused:                  BoolPtr(c.IsPaused),
+		Address:                   c.Address,
+		ExpectString:              c.ExpectString,
+		Threshold:                 c.Threshold,
+		NumRetries:                c.NumRetries,
+		UptimeSLA:                 c.UptimeSLA,
+		Notes:                     c.Notes,
+		SendResolvedNotifications: c.SendResolvedNotifications,
+	}
+}
+
+// AsRUM converts c into a CheckRUM request.
+func (c Check) AsRUM() CheckRUM {
+	return CheckRUM{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Address:                c.Address,
+		Threshold:              c.Threshold,
+		UptimeSLA:              c.UptimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsRUM2 converts c into a CheckRUM2 request.
+func (c Check) AsRUM2() CheckRUM2 {
+	return CheckRUM2{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Address:                c.Address,
+		Threshold:              c.Threshold,
+		UptimeSLA:              c.UptimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsSMTP converts c into a CheckSMTP request.
+func (c Check) AsSMTP() CheckSMTP {
+	return CheckSMTP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		Username:               c.Username,
+		Password:               c.Password,
+		ExpectString:           c.ExpectString,
+		Encryption:             c.Encryption,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIpVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsSSH converts c into a CheckSSH request.
+func (c Check) AsSSH() CheckSSH {
+	return CheckSSH{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIpVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsSSLCert converts c into a CheckSSLCert request.
+func (c Check) AsSSLCert() CheckSSLCert {
+	return CheckSSLCert{
+		Name:          c.Name,
+		ContactGroups: c.ContactGroups,
+		Locations:     c.Locations,
+		Tags:          c.Tags,
+		IsPaused:      BoolPtr(c.IsPaused),
+		Protocol:      c.Protocol,
+		Address:       c.Address,
+		Port:          c.Port,
+		Threshold:     c.Threshold,
+		NumRetries:    c.NumRetries,
+		UptimeSLA:     c.UptimeSLA,
+		Notes:         c.Notes,
+		SSLConfig:     deref(c.SSLConfig),
+	}
+}
+
+// AsTCP converts c into a CheckTCP request.
+func (c Check) AsTCP() CheckTCP {
+	return CheckTCP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		SendString:             c.SendString,
+		ExpectString:           c.ExpectString,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIpVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+		Encryption:             c.Encryption,
+	}
+}
+
+// AsTransaction converts c into a CheckTransaction request.
+func (c Check) AsTransaction() CheckTransaction {
+	return CheckTransaction{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Threshold:              c.Threshold,
+		Script:                 c.Script,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsUDP converts c into a CheckUDP request.
+func (c Check) AsUDP() CheckUDP {
+	return CheckUDP{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		Interval:               c.Interval,
+		Address:                c.Address,
+		Port:                   c.Port,
+		SendString:             c.SendString,
+		ExpectString:           c.ExpectString,
+		Sensitivity:            c.Sensitivity,
+		NumRetries:             c.NumRetries,
+		UseIpVersion:           c.UseIPVersion,
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+	}
+}
+
+// AsWebhook converts c into a CheckWebhook request.
+func (c Check) AsWebhook() CheckWebhook {
+	return CheckWebhook{
+		Name:                   c.Name,
+		ContactGroups:          c.ContactGroups,
+		Locations:              c.Locations,
+		Tags:                   c.Tags,
+		IsPaused:               BoolPtr(c.IsPaused),
+		UptimeSLA:              c.UptimeSLA,
+		ResponseTimeSLA:        c.ResponseTimeSLA,
+		Notes:                  c.Notes,
+		IncludeInGlobalMetrics: BoolPtr(c.IncludeInGlobalMetrics),
+		WebhookUrl:             c.WebhookURL,
+	}
+}
+
+// AsWHOIS converts c into a CheckWHOIS request.
+func (c Check) AsWHOIS() CheckWHOIS {
+	return CheckWHOIS{
+		Name:          c.Name,
+		ContactGroups: c.ContactGroups,
+		Locations:     c.Locations,
+		Tags:          c.Tags,
+		IsPaused:      BoolPtr(c.IsPaused),
+		Address:       c.Address,
+		ExpectString:  c.ExpectString,
+		Threshold:     c.Threshold,
+		NumRetries:    c.NumRetries,
+		UptimeSLA:     c.UptimeSLA,
+		Notes:         c.Notes,
+	}
+}
+
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
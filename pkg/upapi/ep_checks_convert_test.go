@@ -0,0 +1,101 @@
+package upapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// fill sets every field of the struct v points to to a non-zero value.
+func fill(t *testing.T, v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(t, v.Elem(), path)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(decimal.Decimal{}) {
+			v.Set(reflect.ValueOf(decimal.RequireFromString("99.95")))
+			return
+		}
+		if v.Type() == reflect.TypeOf(CloudStatusGroup{}) {
+			// Only the ID is sent on write.
+			v.Set(reflect.ValueOf(CloudStatusGroup{ID: 42}))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			fill(t, v.Field(i), path+"."+v.Type().Field(i).Name)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(t, v.Index(0), path+"[0]")
+	case reflect.String:
+		v.SetString("value of " + path)
+	case reflect.Int, reflect.Int64:
+		v.SetInt(int64(len(path)))
+	case reflect.Bool:
+		v.SetBool(true)
+	default:
+		t.Fatalf("%s: unsupported kind %s", path, v.Kind())
+	}
+}
+
+func TestCheckToRequest(t *testing.T) {
+	tests := map[string]any{
+		"API":         CheckAPI{},
+		"BLACKLIST":   CheckBlacklist{},
+		"CLOUDSTATUS": CheckCloudStatus{},
+		"DNS":         CheckDNS{},
+		"GROUP":       CheckGroup{},
+		"HEARTBEAT":   CheckHeartbeat{},
+		"HTTP":        CheckHTTP{},
+		"ICMP":        CheckICMP{},
+		"IMAP":        CheckIMAP{},
+		"MALWARE":     CheckMalware{},
+		"NTP":         CheckNTP{},
+		"PAGESPEED":   CheckPageSpeed{},
+		"POP":         CheckPOP{},
+		"RDAP":        CheckRDAP{},
+		"RUM":         CheckRUM{},
+		"RUM2":        CheckRUM2{},
+		"SMTP":        CheckSMTP{},
+		"SSH":         CheckSSH{},
+		"SSL_CERT":    CheckSSLCert{},
+		"TCP":         CheckTCP{},
+		"TRANSACTION": CheckTransaction{},
+		"UDP":         CheckUDP{},
+		"WEBHOOK":     CheckWebhook{},
+		"WHOIS":       CheckWHOIS{},
+	}
+	for typ, zero := range tests {
+		t.Run(typ, func(t *testing.T) {
+			want := reflect.New(reflect.TypeOf(zero))
+			fill(t, want.Elem(), want.Elem().Type().Name())
+
+			data, err := json.Marshal(want.Interface())
+			require.NoError(t, err)
+			var check Check
+			require.NoError(t, json.Unmarshal(data, &check))
+			check.CheckType = typ
+
+			got, err := check.ToRequest()
+			require.NoError(t, err)
+			require.Equal(t, want.Elem().Interface(), got)
+		})
+	}
+}
+
+func TestCheckToRequestUnknownType(t *testing.T) {
+	_, err := Check{CheckType: "GOPHER"}.ToRequest()
+	require.EqualError(t, err, `unsupported check type "GOPHER"`)
+}
+
+func TestCheckAsSSLCertNilConfig(t *testing.T) {
+	c := Check{Name: "web", IsPaused: false}
+	rq := c.AsSSLCert()
+	require.Equal(t, "web", rq.Name)
+	require.Equal(t, CheckSSLCertConfig{}, rq.SSLConfig)
+	require.False(t, *rq.IsPaused)
+}
@@ -16,7 +16,7 @@ type CheckHTTP struct {
 	Address                string          `json:"msp_address,omitempty"`
 	Port                   int64           `json:"msp_port,omitempty"`
 	Username               string          `json:"msp_username,omitempty"`
-	Password               string          `json:"msp_password,omitempty"`
+	Password               string          `json:"msp_password,omitempty" redact:"true"`
 	Proxy                  string          `json:"msp_proxy,omitempty"`
 	StatusCode             string          `json:"msp_status_code,omitempty"`
 	SendString             string          `json:"msp_send_string,omitempty"`
@@ -11,7 +11,7 @@ type CheckPageSpeed struct {
 	Address       string               `json:"msp_address,omitempty"`
 	Interval      int64                `json:"msp_interval,omitempty"`
 	Username      string               `json:"msp_username,omitempty"`
-	Password      string               `json:"msp_password,omitempty"`
+	Password      string               `json:"msp_password,omitempty" redact:"true"`
 	Headers       string               `json:"msp_headers,omitempty"`
 	Script        string               `json:"msp_script,omitempty"`
 	NumRetries    int64                `json:"msp_num_retries,omitempty"`
@@ -0,0 +1,268 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CheckRequest is implemented by the per-type check request structs such as
+// CheckHTTP and CheckDNS.
+type CheckRequest interface {
+	// CheckType returns the type of check the request describes, e.g. "HTTP".
+	CheckType() string
+	// CheckName returns the name of the check.
+	CheckName() string
+	Validator
+}
+
+// CheckSaveOptions specifies the optional parameters to the
+// ChecksEndpoint.Save method.
+type CheckSaveOptions struct {
+	// PK selects the check to update. When nil, Save creates a check unless
+	// Upsert finds one.
+	PK PrimaryKeyable
+	// Upsert updates the check of the same type whose name matches the
+	// request exactly, if there is one, instead of creating another check.
+	Upsert bool
+}
+
+type checksEndpointSaveImpl struct {
+	cbd CBD
+}
+
+func (c checksEndpointSaveImpl) Save(ctx context.Context, check CheckRequest, opts CheckSaveOptions) (*Check, error) {
+	pk := opts.PK
+	if pk == nil && opts.Upsert {
+		var err error
+		if pk, err = c.findByName(ctx, check); err != nil {
+			return nil, err
+		}
+	}
+	if pk != nil {
+		return NewEndpointUpdater[CheckRequest, CheckCreateUpdateResponse, Check](c.cbd, "checks").Update(ctx, pk, check)
+	}
+	endpoint := "checks/add-" + strings.ToLower(strings.ReplaceAll(check.CheckType(), "_", "-"))
+	return NewEndpointCreator[CheckRequest, CheckCreateUpdateResponse, Check](c.cbd, endpoint).Create(ctx, check)
+}
+
+func (c checksEndpointSaveImpl) findByName(ctx context.Context, check CheckRequest) (PrimaryKeyable, error) {
+	name := check.CheckName()
+	if name == "" {
+		return nil, fmt.Errorf("upsert requires a check name")
+	}
+	lister := NewEndpointLister[CheckListResponse, Check, CheckListOptions](c.cbd, "checks")
+	candidates, err := ListAllChecks(ctx, lister, CheckListOptions{Search: name})
+	if err != nil {
+		return nil, err
+	}
+	var found *Check
+	for i := range candidates {
+		if candidates[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("upsert: more than one check is named %q", name)
+		}
+		found = &candidates[i]
+	}
+	if found == nil {
+		return nil, nil
+	}
+	if found.CheckType != check.CheckType() {
+		return nil, fmt.Errorf("upsert: check %q (%d) is of type %s, not %s", name, found.PK, found.CheckType, check.CheckType())
+	}
+	return found, nil
+}
+
+func (CheckAPI) CheckType() string {
+	return "API"
+}
+
+func (c CheckAPI) CheckName() string {
+	return c.Name
+}
+
+func (CheckBlacklist) CheckType() string {
+	return "BLACKLIST"
+}
+
+func (c CheckBlacklist) CheckName() string {
+	return c.Name
+}
+
+func (CheckCloudStatus) CheckType() string {
+	return "CLOUDSTATUS"
+}
+
+func (c CheckCloudStatus) CheckName() string {
+	return c.Name
+}
+
+func (CheckDNS) CheckType() string {
+	return "DNS"
+}
+
+func (c CheckDNS) CheckName() string {
+	return c.Name
+}
+
+func (CheckGroup) CheckType() string {
+	return "GROUP"
+}
+
+func (c CheckGroup) CheckName() string {
+	return c.Name
+}
+
+func (CheckHeartbeat) CheckType() string {
+	return "HEARTBEAT"
+}
+
+func (c CheckHeartbeat) CheckName() string {
+	return c.Name
+}
+
+func (CheckHTTP) CheckType() string {
+	return "HTTP"
+}
+
+func (c CheckHTTP) CheckName() string {
+	return c.Name
+}
+
+func (CheckICMP) CheckType() string {
+	return "ICMP"
+}
+
+func (c CheckICMP) CheckName() string {
+	return c.Name
+}
+
+func (CheckIMAP) CheckType() string {
+	return "IMAP"
+}
+
+func (c CheckIMAP) CheckName() string {
+	return c.Name
+}
+
+func (CheckMalware) CheckType() string {
+	return "MALWARE"
+}
+
+func (c CheckMalware) CheckName() string {
+	return c.Name
+}
+
+func (CheckNTP) CheckType() string {
+	return "NTP"
+}
+
+func (c CheckNTP) CheckName() string {
+	return c.Name
+}
+
+func (CheckPageSpeed) CheckType() string {
+	return "PAGESPEED"
+}
+
+func (c CheckPageSpeed) CheckName() string {
+	return c.Name
+}
+
+func (CheckPOP) CheckType() string {
+	return "POP"
+}
+
+func (c CheckPOP) CheckName() string {
+	return c.Name
+}
+
+func (CheckRDAP) CheckType() string {
+	return "RDAP"
+}
+
+func (c CheckRDAP) CheckName() string {
+	return c.Name
+}
+
+func (CheckRUM) CheckType() string {
+	return "RUM"
+}
+
+func (c CheckRUM) CheckName() string {
+	return c.Name
+}
+
+func (CheckRUM2) CheckType() string {
+	return "RUM2"
+}
+
+func (c CheckRUM2) CheckName() string {
+	return c.Name
+}
+
+func (CheckSMTP) CheckType() string {
+	return "SMTP"
+}
+
+func (c CheckSMTP) CheckName() string {
+	return c.Name
+}
+
+func (CheckSSH) CheckType() string {
+	return "SSH"
+}
+
+func (c CheckSSH) CheckName() string {
+	return c.Name
+}
+
+func (CheckSSLCert) CheckType() string {
+	return "SSL_CERT"
+}
+
+func (c CheckSSLCert) CheckName() string {
+	return c.Name
+}
+
+func (CheckTCP) CheckType() string {
+	return "TCP"
+}
+
+func (c CheckTCP) CheckName() string {
+	return c.Name
+}
+
+func (CheckTransaction) CheckType() string {
+	return "TRANSACTION"
+}
+
+func (c CheckTransaction) CheckName() string {
+	return c.Name
+}
+
+func (CheckUDP) CheckType() string {
+	return "UDP"
+}
+
+func (c CheckUDP) CheckName() string {
+	return c.Name
+}
+
+func (CheckWebhook) CheckType() string {
+	return "WEBHOOK"
+}
+
+func (c CheckWebhook) CheckName() string {
+	return c.Name
+}
+
+func (CheckWHOIS) CheckType() string {
+	return "WHOIS"
+}
+
+func (c CheckWHOIS) CheckName() string {
+	return c.Name
+}
@@ -0,0 +1,72 @@
+package upapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksSave(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	var body map[string]any
+	existing := `{"pk": 5, "name": "web", "check_type": "HTTP"}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		_ = json.Unmarshal(data, &body)
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("is_paused") == "true" {
+				_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"count": 2, "results": [{"pk": 4, "name": "web-2", "check_type": "HTTP"}, ` + existing + `]}`))
+		default:
+			_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 5, "name": "web"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	_, err = api.Checks().Save(ctx, CheckSSLCert{Name: "cert", Address: "example.com"}, CheckSaveOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"POST /checks/add-ssl-cert/"}, requests)
+	require.Equal(t, "example.com", body["msp_address"])
+
+	requests = nil
+	_, err = api.Checks().Save(ctx, CheckDNS{Name: "dns"}, CheckSaveOptions{PK: PrimaryKey(9)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"PATCH /checks/9/"}, requests)
+
+	requests = nil
+	check, err := api.Checks().Save(ctx, CheckHTTP{Name: "web", Address: "https://example.com"}, CheckSaveOptions{Upsert: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), check.PK)
+	require.Equal(t, []string{
+		"GET /checks/?is_paused=false&page=1&page_size=100&search=web",
+		"GET /checks/?is_paused=true&page=1&page_size=100&search=web",
+		"PATCH /checks/5/",
+	}, requests)
+
+	requests = nil
+	_, err = api.Checks().Save(ctx, CheckHTTP{Name: "new"}, CheckSaveOptions{Upsert: true})
+	require.NoError(t, err)
+	require.Equal(t, "POST /checks/add-http/", requests[len(requests)-1])
+
+	_, err = api.Checks().Save(ctx, CheckDNS{Name: "web"}, CheckSaveOptions{Upsert: true})
+	require.ErrorContains(t, err, "is of type HTTP, not DNS")
+
+	existing = `{"pk": 5, "name": "web", "check_type": "HTTP"}, {"pk": 6, "name": "web", "check_type": "HTTP"}`
+	_, err = api.Checks().Save(ctx, CheckHTTP{Name: "web"}, CheckSaveOptions{Upsert: true})
+	require.ErrorContains(t, err, `more than one check is named "web"`)
+}
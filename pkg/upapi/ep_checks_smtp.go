@@ -16,7 +16,7 @@ type CheckSMTP struct {
 	Address                string          `json:"msp_address,omitempty"`
 	Port                   int64           `json:"msp_port,omitempty"`
 	Username               string          `json:"msp_username,omitempty"`
-	Password               string          `json:"msp_password,omitempty"`
+	Password               string          `json:"msp_password,omitempty" redact:"true"`
 	ExpectString           string          `json:"msp_expect_string,omitempty"`
 	Encryption             *string         `json:"msp_encryption,omitempty"`
 	Sensitivity            int64           `json:"msp_sensitivity,omitempty"`
@@ -0,0 +1,32 @@
+package upapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksEndpoint_Stats(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/checks/42/stats/", r.URL.Path)
+		require.Equal(t, "2024-01-01", r.URL.Query().Get("start_date"))
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"start_date":"2024-01-01","end_date":"2024-01-01","statistics":[{"date":"2024-01-01","outages":2,"downtime_secs":60}]}`)
+	}))
+	defer srv.Close()
+
+	api, err := New(WithBaseURL(srv.URL+"/api/v1/"), WithToken("test"))
+	require.NoError(t, err)
+
+	// Any PrimaryKeyable identifies the check, not only a PrimaryKey.
+	result, err := api.Checks().Stats(ctx, Check{PK: 42}, CheckStatsOptions{StartDate: "2024-01-01"})
+	require.NoError(t, err)
+	require.Equal(t, []CheckStats{{Date: "2024-01-01", Outages: 2, DowntimeSecs: 60}}, result.Items)
+}
@@ -6,10 +6,10 @@ import (
 
 type CredentialSecret struct {
 	Certificate string `json:"certificate,omitempty"`
-	Key         string `json:"key,omitempty"`
-	Password    string `json:"password,omitempty"`
-	Passphrase  string `json:"passphrase,omitempty"`
-	Secret      string `json:"secret,omitempty"`
+	Key         string `json:"key,omitempty" redact:"true"`
+	Password    string `json:"password,omitempty" redact:"true"`
+	Passphrase  string `json:"passphrase,omitempty" redact:"true"`
+	Secret      string `json:"secret,omitempty" redact:"true"`
 }
 
 type Credential struct {
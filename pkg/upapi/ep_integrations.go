@@ -2,6 +2,8 @@ package upapi
 
 import (
 	"context"
+	"reflect"
+	"sync"
 )
 
 // Integration represents an integration in Uptime.com.
@@ -16,7 +18,7 @@ type Integration struct {
 
 	// Optional fields for particular integrations
 	APIEndpoint string `json:"api_endpoint,omitempty"`
-	APIKey      string `json:"api_key,omitempty"`
+	APIKey      string `json:"api_key,omitempty" redact:"true"`
 	Teams       string `json:"teams,omitempty"`
 	Tags        string `json:"tags,omitempty"`
 	Autoresolve bool   `json:"autoresolve,omitempty"`
@@ -218,12 +220,34 @@ type integrationsEndpointImpl struct {
 	EndpointDeleter
 }
 
+// List and Get return the fields of any integration module, so their bodies
+// are redacted as those of every module.
+func (i *integrationsEndpointImpl) List(ctx context.Context, opts IntegrationListOptions) (*ListResult[Integration], error) {
+	return i.EndpointLister.List(withRedacted(ctx, integrationModels()...), opts)
+}
+
+func (i *integrationsEndpointImpl) Get(ctx context.Context, pk PrimaryKeyable) (*Integration, error) {
+	return i.EndpointGetter.Get(withRedacted(ctx, integrationModels()...), pk)
+}
+
+// integrationModels returns the request types of the integration modules,
+// taken from the last argument of the methods of IntegrationsEndpoint.
+var integrationModels = sync.OnceValue(func() []reflect.Type {
+	t := typeOf[IntegrationsEndpoint]()
+	types := make([]reflect.Type, 0, t.NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i).Type
+		types = append(types, m.In(m.NumIn()-1))
+	}
+	return types
+})
+
 type IntegrationCachet struct {
 	PK            int64    `json:"pk,omitempty"`
 	CachetURL     string   `json:"url,omitempty"`
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	Token         string   `json:"token,omitempty"`
+	Token         string   `json:"token,omitempty" redact:"true"`
 	Component     string   `json:"component,omitempty"`
 	Metric        string   `json:"metric,omitempty"`
 }
@@ -248,8 +272,8 @@ func (i integrationsEndpointCachetImpl) UpdateCachet(ctx context.Context, pk Pri
 type IntegrationDatadog struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	APIKey        string   `json:"api_key,omitempty"`
-	APPKey        string   `json:"app_key,omitempty"`
+	APIKey        string   `json:"api_key,omitempty" redact:"true"`
+	APPKey        string   `json:"app_key,omitempty" redact:"true"`
 	Region        string   `json:"region,omitempty"`
 }
 
@@ -269,7 +293,7 @@ func (i integrationsEndpointDatadogImpl) UpdateDatadog(ctx context.Context, pk P
 type IntegrationGeckoboard struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	APIKey        string   `json:"api_key,omitempty"`
+	APIKey        string   `json:"api_key,omitempty" redact:"true"`
 	DatasetName   string   `json:"dataset_name,omitempty"`
 }
 
@@ -290,7 +314,7 @@ type IntegrationJiraServicedesk struct {
 	Name                     string   `json:"name,omitempty"`
 	ContactGroups            []string `json:"contact_groups,omitempty"`
 	APIEmail                 string   `json:"api_email,omitempty"`
-	APIToken                 string   `json:"api_token,omitempty"`
+	APIToken                 string   `json:"api_token,omitempty" redact:"true"`
 	JiraSubdomain            string   `json:"jira_subdomain,omitempty"`
 	ProjectKey               string   `json:"project_key,omitempty"`
 	Labels                   string   `json:"labels,omitempty"`
@@ -316,7 +340,7 @@ func (i integrationsEndpointJiraServicedeskImpl) UpdateJiraServiceDesk(ctx conte
 type IntegrationKlipfolio struct {
 	Name           string   `json:"name,omitempty"`
 	ContactGroups  []string `json:"contact_groups,omitempty"`
-	APIKey         string   `json:"api_key,omitempty"`
+	APIKey         string   `json:"api_key,omitempty" redact:"true"`
 	DataSourceName string   `json:"data_source_name,omitempty"`
 }
 
@@ -337,7 +361,7 @@ type IntegrationLibrato struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
 	Email         string   `json:"email,omitempty"`
-	APIToken      string   `json:"api_token,omitempty"`
+	APIToken      string   `json:"api_token,omitempty" redact:"true"`
 	MetricName    string   `json:"metric_name,omitempty"`
 }
 
@@ -357,7 +381,7 @@ func (i integrationsEndpointLibratoImpl) UpdateLibrato(ctx context.Context, pk P
 type IntegrationMicrosoftTeams struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	WebhookUrl    string   `json:"webhook_url,omitempty"`
+	WebhookUrl    string   `json:"webhook_url,omitempty" redact:"true"`
 }
 
 type integrationsEndpointMicrosoftTeamsImpl struct {
@@ -377,7 +401,7 @@ type IntegrationOpsgenie struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
 	APIEndpoint   string   `json:"api_endpoint,omitempty"`
-	APIKey        string   `json:"api_key,omitempty"`
+	APIKey        string   `json:"api_key,omitempty" redact:"true"`
 	Teams         string   `json:"teams,omitempty"`
 	Tags          string   `json:"tags,omitempty"`
 	Autoresolve   bool     `json:"autoresolve"`
@@ -399,7 +423,7 @@ func (i integrationsEndpointOpsgenieImpl) UpdateOpsgenie(ctx context.Context, pk
 type IntegrationPagerduty struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	ServiceKey    string   `json:"service_key,omitempty"`
+	ServiceKey    string   `json:"service_key,omitempty" redact:"true"`
 	Autoresolve   bool     `json:"autoresolve"`
 }
 
@@ -458,7 +482,7 @@ func (i integrationsEndpointPushoverImpl) UpdatePushover(ctx context.Context, pk
 type IntegrationSlack struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	WebhookURL    string   `json:"webhook_url,omitempty"`
+	WebhookURL    string   `json:"webhook_url,omitempty" redact:"true"`
 	Channel       string   `json:"channel,omitempty"`
 }
 
@@ -480,7 +504,7 @@ type IntegrationStatus struct {
 	ContactGroups []string `json:"contact_groups,omitempty"`
 	StatuspageID  string   `json:"statuspage_id,omitempty"`
 	APIID         string   `json:"api_id,omitempty"`
-	APIKey        string   `json:"api_key,omitempty"`
+	APIKey        string   `json:"api_key,omitempty" redact:"true"`
 	Component     string   `json:"component,omitempty"`
 	Container     string   `json:"container,omitempty"`
 	Metric        string   `json:"metric,omitempty"`
@@ -502,7 +526,7 @@ func (i integrationsEndpointStatusImpl) UpdateStatus(ctx context.Context, pk Pri
 type IntegrationStatuspage struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	APIKey        string   `json:"api_key,omitempty"`
+	APIKey        string   `json:"api_key,omitempty" redact:"true"`
 	Page          string   `json:"page,omitempty"`
 	Component     string   `json:"component,omitempty"`
 	Metric        string   `json:"metric,omitempty"`
@@ -524,8 +548,8 @@ func (i integrationsEndpointStatuspageImpl) UpdateStatuspage(ctx context.Context
 type IntegrationTwitter struct {
 	Name             string   `json:"name,omitempty"`
 	ContactGroups    []string `json:"contact_groups,omitempty"`
-	OauthToken       string   `json:"oauth_token,omitempty"`
-	OauthTokenSecret string   `json:"oauth_token_secret,omitempty"`
+	OauthToken       string   `json:"oauth_token,omitempty" redact:"true"`
+	OauthTokenSecret string   `json:"oauth_token_secret,omitempty" redact:"true"`
 }
 
 type integrationsEndpointTwitterImpl struct {
@@ -544,8 +568,8 @@ func (i integrationsEndpointTwitterImpl) UpdateTwitter(ctx context.Context, pk P
 type IntegrationVictorops struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	ServiceKey    string   `json:"service_key,omitempty"`
-	RoutingKey    string   `json:"routing_key,omitempty"`
+	ServiceKey    string   `json:"service_key,omitempty" redact:"true"`
+	RoutingKey    string   `json:"routing_key,omitempty" redact:"true"`
 }
 
 type integrationsEndpointVictoropsImpl struct {
@@ -565,7 +589,7 @@ type IntegrationWavefront struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
 	WavefrontUrl  string   `json:"wavefront_url,omitempty"`
-	APIToken      string   `json:"api_token,omitempty"`
+	APIToken      string   `json:"api_token,omitempty" redact:"true"`
 }
 
 type integrationsEndpointWavefrontImpl struct {
@@ -585,7 +609,7 @@ type IntegrationWebhook struct {
 	Name             string   `json:"name,omitempty"`
 	ContactGroups    []string `json:"contact_groups,omitempty"`
 	PostbackUrl      string   `json:"postback_url,omitempty"`
-	Headers          string   `json:"headers,omitempty"`
+	Headers          string   `json:"headers,omitempty" redact:"true"`
 	UseLegacyPayload bool     `json:"use_legacy_payload"`
 }
 
@@ -605,7 +629,7 @@ func (i integrationsEndpointWebhookImpl) UpdateWebhook(ctx context.Context, pk P
 type IntegrationZapier struct {
 	Name          string   `json:"name,omitempty"`
 	ContactGroups []string `json:"contact_groups,omitempty"`
-	WebhookUrl    string   `json:"webhook_url,omitempty"`
+	WebhookUrl    string   `json:"webhook_url,omitempty" redact:"true"`
 }
 
 type integrationsEndpointZapierImpl struct {
@@ -2,6 +2,7 @@ package upapi
 
 import (
 	"context"
+	"time"
 )
 
 type PushNotificationProfile struct {
@@ -59,7 +60,7 @@ func (p PushNotificationProfileCreateUpdateResponse) Item() PushNotificationProf
 }
 
 type PushNotificationProfileCreateRequest struct {
-	AppKey        string   `json:"app_key" flag:"app-key"`
+	AppKey        string   `json:"app_key" flag:"app-key" redact:"true"`
 	UUID          string   `json:"uuid,omitempty" flag:"uuid"`
 	DeviceName    string   `json:"device_name" flag:"device-name"`
 	ContactGroups []string `json:"contact_groups" flag:"contact-groups"`
@@ -75,6 +76,7 @@ type PushNotificationsEndpoint interface {
 	Create(context.Context, PushNotificationProfileCreateRequest) (*PushNotificationProfile, error)
 	Get(context.Context, PrimaryKeyable) (*PushNotificationProfile, error)
 	Update(context.Context, PrimaryKeyable, PushNotificationProfileUpdateRequest) (*PushNotificationProfile, error)
+	UpdateIfUnmodified(context.Context, PrimaryKeyable, time.Time, PushNotificationProfileUpdateRequest, MergeFunc[PushNotificationProfileUpdateRequest, PushNotificationProfile]) (*PushNotificationProfile, error)
 	Delete(context.Context, PrimaryKeyable) error
 }
 
@@ -18,7 +18,7 @@ type StatusPage struct {
 	AllowSearchIndexing       bool   `json:"allow_search_indexing"`
 	AllowDrillDown            bool   `json:"allow_drill_down"`
 	AuthUsername              string `json:"auth_username"`
-	AuthPassword              string `json:"auth_password"`
+	AuthPassword              string `json:"auth_password" redact:"true"`
 	MaxVisibleComponentDays   int64  `json:"max_visible_component_days,omitempty"`
 	ShowStatusTab             bool   `json:"show_status_tab"`
 	ShowActiveIncidents       bool   `json:"show_active_incidents"`
@@ -51,6 +51,7 @@ func NewStatusPageCurrentStatusEndpoint(cbd CBD, statusPagePK PrimaryKeyable) St
 }
 
 func (e *statusPageCurrentStatusEndpointImpl) Get(ctx context.Context) (*StatusPageCurrentStatus, error) {
+	ctx = withRedacted(ctx, typeOf[StatusPageCurrentStatusResponse]())
 	rq, err := e.BuildRequest(ctx, "GET", e.endpoint+"/", nil, nil)
 	if err != nil {
 		return nil, err
@@ -75,6 +75,7 @@ type subaccountsEndpointImpl struct {
 // List returns all subaccounts. Note: This endpoint returns a simple array, not a paginated response.
 func (e *subaccountsEndpointImpl) List(ctx context.Context) ([]Subaccount, error) {
 	path := e.endpoint + "/"
+	ctx = withRedacted(ctx, typeOf[[]Subaccount]())
 	req, err := e.BuildRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
@@ -98,7 +99,7 @@ func (e *subaccountsEndpointImpl) List(ctx context.Context) ([]Subaccount, error
 // Positive num transfers from main to subaccount, negative transfers from subaccount to main.
 func (e *subaccountsEndpointImpl) TransferPacks(ctx context.Context, pk PrimaryKeyable, packs SubaccountPacks) error {
 	path := fmt.Sprintf("%s/%d/allocation/", e.endpoint, pk.PrimaryKey())
-	req, err := e.BuildRequest(ctx, "POST", path, packs, nil)
+	req, err := e.BuildRequest(ctx, "POST", path, nil, packs)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,31 @@
+package upapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubaccountsEndpoint_TransferPacks(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/auth/subaccounts/7/allocation/", r.URL.Path)
+		require.Empty(t, r.URL.RawQuery)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"num": -2}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	api, err := New(WithBaseURL(srv.URL+"/api/v1/"), WithToken("test"))
+	require.NoError(t, err)
+
+	require.NoError(t, api.Subaccounts().TransferPacks(ctx, PrimaryKey(7), SubaccountPacks{Num: -2}))
+}
@@ -18,7 +18,7 @@ type User struct {
 	FirstName           string       `json:"first_name,omitempty"`
 	LastName            string       `json:"last_name,omitempty"`
 	Email               string       `json:"email,omitempty"`
-	Password            string       `json:"password,omitempty"`
+	Password            string       `json:"password,omitempty" redact:"true"`
 	IsActive            bool         `json:"is_active,omitempty"`
 	IsPrimary           bool         `json:"is_primary,omitempty"`
 	AccessLevel         string       `json:"access_level,omitempty"`
@@ -80,7 +80,7 @@ type UserCreateRequest struct {
 	FirstName           string   `json:"first_name,omitempty" flag:"first-name"`
 	LastName            string   `json:"last_name,omitempty" flag:"last-name"`
 	Email               string   `json:"email" flag:"email"`
-	Password            string   `json:"password" flag:"password"`
+	Password            string   `json:"password" flag:"password" redact:"true"`
 	AccessLevel         string   `json:"access_level,omitempty" flag:"access-level"`
 	IsAPIEnabled        bool     `json:"is_api_enabled,omitempty" flag:"api-enabled"`
 	NotifyPaidInvoices  bool     `json:"notify_paid_invoices,omitempty" flag:"notify-paid-invoices"`
@@ -92,7 +92,7 @@ type UserUpdateRequest struct {
 	FirstName           string   `json:"first_name,omitempty" flag:"first-name"`
 	LastName            string   `json:"last_name,omitempty" flag:"last-name"`
 	Email               string   `json:"email,omitempty" flag:"email"`
-	Password            string   `json:"password,omitempty" flag:"password"`
+	Password            string   `json:"password,omitempty" flag:"password" redact:"true"`
 	AccessLevel         string   `json:"access_level,omitempty" flag:"access-level"`
 	IsAPIEnabled        *bool    `json:"is_api_enabled,omitempty" flag:"api-enabled"`
 	NotifyPaidInvoices  *bool    `json:"notify_paid_invoices,omitempty" flag:"notify-paid-invoices"`
@@ -135,6 +135,7 @@ type usersEndpointImpl struct {
 
 func (e *usersEndpointImpl) Deactivate(ctx context.Context, pk PrimaryKeyable) (*User, error) {
 	path := fmt.Sprintf("%s/%d/deactivate/", e.endpoint, pk.PrimaryKey())
+	ctx = withRedacted(ctx, typeOf[UserCreateUpdateResponse]())
 	req, err := e.BuildRequest(ctx, "POST", path, nil, nil)
 	if err != nil {
 		return nil, err
@@ -157,6 +158,7 @@ func (e *usersEndpointImpl) Deactivate(ctx context.Context, pk PrimaryKeyable) (
 
 func (e *usersEndpointImpl) Reactivate(ctx context.Context, pk PrimaryKeyable) (*User, error) {
 	path := fmt.Sprintf("%s/%d/reactivate/", e.endpoint, pk.PrimaryKey())
+	ctx = withRedacted(ctx, typeOf[UserCreateUpdateResponse]())
 	req, err := e.BuildRequest(ctx, "POST", path, nil, nil)
 	if err != nil {
 		return nil, err
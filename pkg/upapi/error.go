@@ -1,17 +1,58 @@
 package upapi
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
 
 var DecodeError = errors.New("error response decode error")
 
+// Sentinel errors matched by *Error through errors.Is, e.g.
+//
+//	if errors.Is(err, upapi.ErrNotFound) { ... }
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrRateLimited = errors.New("rate limited")
+	ErrValidation  = errors.New("validation failed")
+	ErrAuth        = errors.New("authentication failed")
+	ErrServerError = errors.New("server error")
+)
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited reports whether err is an API error caused by throttling.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsValidation reports whether err is an API error rejecting the submitted
+// data. Use errors.As to get at the field errors.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsAuth reports whether err is an API error caused by missing or invalid
+// credentials or insufficient permissions.
+func IsAuth(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsServerError reports whether err is an API error with a 5xx status.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
+
 // FieldErrors is the decoded `error_fields` payload. The server returns a
 // flat `field -> [msg, ...]` map for top-level field errors and a nested
 // `field -> {subfield: [msg, ...]}` object when the offending field is
@@ -48,11 +89,130 @@ func flattenFieldErrors(dst FieldErrors, prefix string, src map[string]any) {
 	}
 }
 
+// Messages returns the messages reported for key as strings.
+func (f FieldErrors) Messages(key string) []string {
+	msgs := make([]string, 0, len(f[key]))
+	for _, m := range f[key] {
+		if s, ok := m.(string); ok {
+			msgs = append(msgs, s)
+		} else {
+			msgs = append(msgs, fmt.Sprint(m))
+		}
+	}
+	return msgs
+}
+
+// FieldError is a single entry of FieldErrors resolved against a request
+// type.
+type FieldError struct {
+	// Key is the dotted key reported by the server, e.g.
+	// "cloudstatusconfig.service_name".
+	Key string
+	// Path is the matching Go field path of the request type, e.g.
+	// "CloudStatusConfig.ServiceName", or empty when the key does not map to
+	// a field (such as "non_field_errors").
+	Path     string
+	Messages []string
+}
+
+// Resolve maps every key to the field path of request, which is the value
+// (or a pointer to the value) that was sent. Entries are sorted by key.
+func (f FieldErrors) Resolve(request any) []FieldError {
+	var t reflect.Type
+	if request != nil {
+		t = reflect.TypeOf(request)
+	}
+	out := make([]FieldError, 0, len(f))
+	for key := range f {
+		out = append(out, FieldError{
+			Key:      key,
+			Path:     fieldPath(t, key),
+			Messages: f.Messages(key),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+// fieldPath follows the dotted JSON key through t and returns the Go field
+// path it designates. List indices are rendered as "[n]".
+func fieldPath(t reflect.Type, key string) string {
+	if t == nil {
+		return ""
+	}
+	var path strings.Builder
+	for _, seg := range strings.Split(key, ".") {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(seg); err != nil {
+				return ""
+			}
+			path.WriteString("[" + seg + "]")
+			t = t.Elem()
+		case reflect.Struct:
+			name, ft, ok := jsonField(t, seg)
+			if !ok {
+				return ""
+			}
+			if path.Len() > 0 {
+				path.WriteByte('.')
+			}
+			path.WriteString(name)
+			t = ft
+		default:
+			return ""
+		}
+	}
+	return path.String()
+}
+
+// jsonField finds the field of struct type t encoded under the JSON name,
+// descending into embedded structs the way encoding/json does.
+func jsonField(t reflect.Type, name string) (string, reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if path, typ, ok := jsonField(ft, name); ok {
+					return path, typ, true
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if tag == name || (tag == "" && strings.EqualFold(f.Name, name)) {
+			return f.Name, f.Type, true
+		}
+	}
+	return "", nil, false
+}
+
+// Error is returned for every API response with an unexpected status code.
 type Error struct {
-	Response *http.Response
-	Code     string      `json:"error_code"`
-	Message  string      `json:"error_message"`
-	Fields   FieldErrors `json:"error_fields,omitempty"`
+	Response   *http.Response
+	StatusCode int         `json:"-"`
+	Code       string      `json:"error_code"`
+	Message    string      `json:"error_message"`
+	Fields     FieldErrors `json:"error_fields,omitempty"`
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+	// Err is the reason the body could not be decoded, if it could not.
+	Err error `json:"-"`
 }
 
 func NewError() *Error {
@@ -63,32 +223,88 @@ func NewError() *Error {
 
 func (e Error) Error() string {
 	b := strings.Builder{}
-	b.WriteString(fmt.Sprintf("%s %s failed: Code=%v Message=%s",
-		e.Response.Request.Method,
-		e.Response.Request.URL.String(),
-		e.Code,
-		e.Message,
-	))
-	if e.Fields != nil {
+	if e.Response != nil && e.Response.Request != nil && e.Response.Request.URL != nil {
+		b.WriteString(fmt.Sprintf("%s %s failed: ", e.Response.Request.Method, e.Response.Request.URL.String()))
+	} else {
+		b.WriteString("request failed: ")
+	}
+	b.WriteString(fmt.Sprintf("Code=%v Message=%s", e.Code, e.Message))
+	if len(e.Fields) > 0 {
 		b.WriteString(fmt.Sprintf(", Fields=%v", e.Fields))
 	}
+	if e.Err != nil {
+		b.WriteString(fmt.Sprintf(", Err=%v", e.Err))
+	}
 	return b.String()
 }
 
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches e against the sentinel errors by status code, falling back to
+// the error code for responses without one.
+func (e Error) Is(target error) bool {
+	status := e.StatusCode
+	if status == 0 && e.Response != nil {
+		status = e.Response.StatusCode
+	}
+	code := strings.ToUpper(e.Code)
+	switch target {
+	case ErrNotFound:
+		return status == http.StatusNotFound || code == "NOT_FOUND"
+	case ErrRateLimited:
+		return status == http.StatusTooManyRequests || code == "THROTTLED"
+	case ErrValidation:
+		return code == "VALIDATION_ERROR" || len(e.Fields) > 0 ||
+			(status == http.StatusUnprocessableEntity)
+	case ErrAuth:
+		return status == http.StatusUnauthorized || status == http.StatusForbidden ||
+			code == "NOT_AUTHENTICATED" || code == "AUTHENTICATION_FAILED" || code == "PERMISSION_DENIED"
+	case ErrServerError:
+		return status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// FieldErrors resolves the field errors against request, the value sent in
+// the failed call. See FieldErrors.Resolve.
+func (e Error) FieldErrors(request any) []FieldError {
+	return e.Fields.Resolve(request)
+}
+
 func ErrorFromResponse(r *http.Response) error {
+	body, err := io.ReadAll(r.Body)
+	e := &Error{
+		Response:   r,
+		StatusCode: r.StatusCode,
+		Code:       strconv.Itoa(r.StatusCode),
+		Message:    http.StatusText(r.StatusCode),
+		Body:       body,
+	}
+	if err != nil {
+		e.Err = fmt.Errorf("%w: %s", DecodeError, err.Error())
+		return e
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return e
+	}
 	data := new(struct {
-		Error *Error `json:"messages"`
+		Error  *Error `json:"messages"`
+		Detail string `json:"detail"`
 	})
-	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
-		if errors.Is(err, io.EOF) {
-			return &Error{
-				Response: r,
-				Code:     strconv.Itoa(r.StatusCode),
-				Message:  http.StatusText(r.StatusCode),
-			}
+	if err := json.Unmarshal(body, data); err != nil {
+		e.Err = fmt.Errorf("%w: %s", DecodeError, err.Error())
+		return e
+	}
+	if data.Error == nil {
+		if data.Detail != "" {
+			e.Message = data.Detail
 		}
-		return fmt.Errorf("%w: %s", DecodeError, err.Error())
+		return e
 	}
 	data.Error.Response = r
+	data.Error.StatusCode = r.StatusCode
+	data.Error.Body = body
 	return data.Error
 }
@@ -1,6 +1,8 @@
 package upapi
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -74,3 +76,79 @@ func TestErrorFromResponse(t *testing.T) {
 		)
 	})
 }
+
+func TestErrorHelpers(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		is     error
+	}{
+		{http.StatusNotFound, `{"detail": "Not found."}`, ErrNotFound},
+		{http.StatusTooManyRequests, ``, ErrRateLimited},
+		{http.StatusBadRequest, `{"messages": {"error_code": "VALIDATION_ERROR", "error_fields": {"name": ["required"]}}}`, ErrValidation},
+		{http.StatusUnauthorized, `{"messages": {"error_code": "NOT_AUTHENTICATED"}}`, ErrAuth},
+		{http.StatusForbidden, `{"messages": {"error_code": "PERMISSION_DENIED"}}`, ErrAuth},
+		{http.StatusBadGateway, `<html>Bad Gateway</html>`, ErrServerError},
+	}
+	sentinels := []error{ErrNotFound, ErrRateLimited, ErrValidation, ErrAuth, ErrServerError}
+	for _, c := range cases {
+		t.Run(c.is.Error(), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", ErrorFromResponse(&http.Response{
+				StatusCode: c.status,
+				Body:       io.NopCloser(strings.NewReader(c.body)),
+			}))
+			for _, s := range sentinels {
+				require.Equal(t, s == c.is, errors.Is(err, s), s.Error())
+			}
+		})
+	}
+	require.True(t, IsNotFound(&Error{StatusCode: http.StatusNotFound}))
+	require.True(t, IsRateLimited(&Error{StatusCode: http.StatusTooManyRequests}))
+	require.True(t, IsValidation(&Error{Code: "VALIDATION_ERROR"}))
+	require.True(t, IsAuth(&Error{StatusCode: http.StatusUnauthorized}))
+	require.True(t, IsServerError(&Error{StatusCode: http.StatusServiceUnavailable}))
+	require.False(t, IsNotFound(errors.New("not found")))
+}
+
+func TestErrorWithoutResponse(t *testing.T) {
+	err := &Error{Code: "500", Message: "Internal Server Error"}
+	require.Equal(t, "request failed: Code=500 Message=Internal Server Error", err.Error())
+}
+
+func TestErrorPreservesBody(t *testing.T) {
+	rs := http.Response{
+		StatusCode: http.StatusBadGateway,
+		Body:       io.NopCloser(strings.NewReader(`<html>Bad Gateway</html>`)),
+	}
+	err := NewError()
+	require.ErrorAs(t, ErrorFromResponse(&rs), &err)
+	require.ErrorIs(t, err, DecodeError)
+	require.Equal(t, "<html>Bad Gateway</html>", string(err.Body))
+	require.Equal(t, http.StatusBadGateway, err.StatusCode)
+}
+
+func TestFieldErrorsResolve(t *testing.T) {
+	fields := FieldErrors{
+		"cloudstatusconfig.service_name": {"Object with name=aws-ec2-us-east-1 does not exist."},
+		"name":                           {"This field is required."},
+		"non_field_errors":               {"Invalid."},
+	}
+	require.Equal(t, []FieldError{
+		{Key: "cloudstatusconfig.service_name", Path: "CloudStatusConfig.ServiceName", Messages: []string{"Object with name=aws-ec2-us-east-1 does not exist."}},
+		{Key: "name", Path: "Name", Messages: []string{"This field is required."}},
+		{Key: "non_field_errors", Messages: []string{"Invalid."}},
+	}, fields.Resolve(&CheckCloudStatus{}))
+
+	rs := http.Response{
+		StatusCode: http.StatusBadRequest,
+		Body: io.NopCloser(strings.NewReader(`{"messages": {
+			"error_code": "VALIDATION_ERROR",
+			"error_fields": {"escalations": {"0": {"contact_groups": ["Invalid contact."]}}}
+		}}`)),
+	}
+	err := NewError()
+	require.ErrorAs(t, ErrorFromResponse(&rs), &err)
+	require.Equal(t, []FieldError{
+		{Key: "escalations.0.contact_groups", Path: "Escalations[0].ContactGroups", Messages: []string{"Invalid contact."}},
+	}, err.FieldErrors(CheckEscalations{}))
+}
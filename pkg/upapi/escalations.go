@@ -0,0 +1,172 @@
+package upapi
+
+import (
+	"context"
+	"sort"
+)
+
+// EscalationTemplate is a named escalation policy, assigned to the checks
+// carrying any of Tags.
+type EscalationTemplate struct {
+	Name        string            `json:"name" yaml:"name"`
+	Tags        []string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Escalations []CheckEscalation `json:"escalations" yaml:"escalations"`
+}
+
+// Matches reports whether check carries one of the tags of the template.
+func (t EscalationTemplate) Matches(check Check) bool {
+	for _, tag := range check.Tags {
+		for _, want := range t.Tags {
+			if tag == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EscalationChange describes a check whose escalations differ from those of
+// the template it is assigned to.
+type EscalationChange struct {
+	PK       PrimaryKey        `json:"pk"`
+	Name     string            `json:"name"`
+	Template string            `json:"template"`
+	Current  []CheckEscalation `json:"current"`
+	Desired  []CheckEscalation `json:"desired"`
+}
+
+// EscalationConflict is a check assigned to several templates.
+type EscalationConflict struct {
+	PK        PrimaryKey `json:"pk"`
+	Name      string     `json:"name"`
+	Templates []string   `json:"templates"`
+}
+
+// EscalationReport is the result of AuditEscalations.
+type EscalationReport struct {
+	Changes   []EscalationChange   `json:"changes"`
+	Conflicts []EscalationConflict `json:"conflicts"`
+	// Compliant counts the checks following their template.
+	Compliant int `json:"compliant"`
+}
+
+// OK reports whether every check follows the template it is assigned to.
+func (r *EscalationReport) OK() bool {
+	return len(r.Changes) == 0 && len(r.Conflicts) == 0
+}
+
+// PlanEscalations returns the changes needed for the checks carrying any of
+// tags, or any of the template's own tags when none is given, to follow t.
+func PlanEscalations(ctx context.Context, ep ChecksEndpoint, t EscalationTemplate, tags ...string) ([]EscalationChange, error) {
+	if len(tags) > 0 {
+		t.Tags = tags
+	}
+	// Whether the API matches any or all of several tags is not documented, so
+	// only a single one is filtered on server side.
+	var opts CheckListOptions
+	if len(t.Tags) == 1 {
+		opts.Tag = t.Tags
+	}
+	checks, err := ListAllChecks(ctx, ep, opts)
+	if err != nil {
+		return nil, err
+	}
+	changes := []EscalationChange{}
+	for _, c := range checks {
+		if !t.Matches(c) || EscalationsEqual(c.Escalations, t.Escalations) {
+			continue
+		}
+		changes = append(changes, EscalationChange{
+			PK:       PrimaryKey(c.PK),
+			Name:     c.Name,
+			Template: t.Name,
+			Current:  c.Escalations,
+			Desired:  t.Escalations,
+		})
+	}
+	return changes, nil
+}
+
+// ApplyEscalations updates the escalations of the checks in changes through
+// Bulk.
+func ApplyEscalations(ctx context.Context, ep ChecksEndpoint, changes []EscalationChange, opts ...BulkOption) ([]BulkResult[CheckEscalations], error) {
+	ops := make([]BulkOp[CheckEscalations], len(changes))
+	for i := range changes {
+		change := changes[i]
+		ops[i] = func(ctx context.Context) (*CheckEscalations, error) {
+			return ep.UpdateEscalations(ctx, change.PK, CheckEscalations{Escalations: change.Desired})
+		}
+	}
+	return Bulk(ctx, ops, opts...)
+}
+
+// AuditEscalations checks every check carrying the tags of templates
+// follows its template, and that none is assigned to several templates.
+func AuditEscalations(ctx context.Context, ep ChecksEndpoint, templates []EscalationTemplate) (*EscalationReport, error) {
+	checks, err := ListAllChecks(ctx, ep, CheckListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report := &EscalationReport{Changes: []EscalationChange{}, Conflicts: []EscalationConflict{}}
+	for _, c := range checks {
+		var assigned []EscalationTemplate
+		for _, t := range templates {
+			if t.Matches(c) {
+				assigned = append(assigned, t)
+			}
+		}
+		switch {
+		case len(assigned) == 0:
+		case len(assigned) > 1:
+			conflict := EscalationConflict{PK: PrimaryKey(c.PK), Name: c.Name}
+			for _, t := range assigned {
+				conflict.Templates = append(conflict.Templates, t.Name)
+			}
+			report.Conflicts = append(report.Conflicts, conflict)
+		case EscalationsEqual(c.Escalations, assigned[0].Escalations):
+			report.Compliant++
+		default:
+			report.Changes = append(report.Changes, EscalationChange{
+				PK:       PrimaryKey(c.PK),
+				Name:     c.Name,
+				Template: assigned[0].Name,
+				Current:  c.Escalations,
+				Desired:  assigned[0].Escalations,
+			})
+		}
+	}
+	return report, nil
+}
+
+// EscalationsEqual reports whether a and b describe the same escalation
+// steps, in the same order. The order of contact groups within a step does
+// not matter, and no contact groups equals an empty list.
+func EscalationsEqual(a, b []CheckEscalation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].WaitTime != b[i].WaitTime || a[i].NumRepeats != b[i].NumRepeats {
+			return false
+		}
+		ga, gb := sortedGroups(a[i].ContactGroups), sortedGroups(b[i].ContactGroups)
+		if len(ga) != len(gb) {
+			return false
+		}
+		for j := range ga {
+			if ga[j] != gb[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sortedGroups(groups *[]string) []string {
+	if groups == nil {
+		return nil
+	}
+	s := append([]string(nil), *groups...)
+	sort.Strings(s)
+	return s
+}
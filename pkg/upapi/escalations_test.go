@@ -0,0 +1,79 @@
+package upapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalationTemplates(t *testing.T) {
+	ctx := context.Background()
+	var updates []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/checks/":
+			if r.URL.Query().Get("is_paused") == "true" {
+				_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"count": 4, "results": [
+				{"pk": 1, "name": "web", "tags": ["prod"], "escalations": [{"wait_time": 5, "num_repeats": 1, "contact_groups": ["b", "a"]}]},
+				{"pk": 2, "name": "api", "tags": ["prod"], "escalations": []},
+				{"pk": 3, "name": "both", "tags": ["prod", "db"]},
+				{"pk": 4, "name": "other", "tags": ["dev"]}
+			]}`))
+		case r.Method == http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			updates = append(updates, r.URL.Path+" "+strings.TrimSpace(string(body)))
+			_, _ = w.Write([]byte(`{"messages": {}, "results": {"pk": 2}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	prod := EscalationTemplate{
+		Name:        "critical",
+		Tags:        []string{"prod"},
+		Escalations: []CheckEscalation{{WaitTime: 5, NumRepeats: 1, ContactGroups: &[]string{"a", "b"}}},
+	}
+	db := EscalationTemplate{Name: "db", Tags: []string{"db"}}
+
+	changes, err := PlanEscalations(ctx, api.Checks(), prod)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, PrimaryKey(2), changes[0].PK)
+	require.Equal(t, PrimaryKey(3), changes[1].PK)
+
+	_, err = ApplyEscalations(ctx, api.Checks(), changes[:1])
+	require.NoError(t, err)
+	require.Equal(t, []string{`/checks/2/escalations/ {"escalations":[{"wait_time":5,"num_repeats":1,"contact_groups":["a","b"]}]}`}, updates)
+
+	report, err := AuditEscalations(ctx, api.Checks(), []EscalationTemplate{prod, db})
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Equal(t, 1, report.Compliant)
+	require.Len(t, report.Changes, 1)
+	require.Equal(t, "api", report.Changes[0].Name)
+	require.Equal(t, []EscalationConflict{{PK: 3, Name: "both", Templates: []string{"critical", "db"}}}, report.Conflicts)
+}
+
+func TestEscalationsEqual(t *testing.T) {
+	require.True(t, EscalationsEqual(nil, []CheckEscalation{}))
+	require.True(t, EscalationsEqual(
+		[]CheckEscalation{{WaitTime: 1, ContactGroups: &[]string{}}},
+		[]CheckEscalation{{WaitTime: 1}},
+	))
+	require.False(t, EscalationsEqual(
+		[]CheckEscalation{{WaitTime: 1, ContactGroups: &[]string{"a"}}},
+		[]CheckEscalation{{WaitTime: 1, ContactGroups: &[]string{"b"}}},
+	))
+}
@@ -0,0 +1,213 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Export returns the manifests of every resource of the given kinds, or of
+// every kind of manifest when none is given. Nested resources are listed in
+// the spec of their parent, references are made by name, and server-managed
+// fields and secrets are left out, so that PlanManifests finds nothing to
+// change for the exported manifests.
+//
+// Manifests identify resources by kind and name, so resources sharing both
+// with another are left out. Export then returns the manifests of the others
+// along with a *DuplicateNamesError listing them.
+func Export(ctx context.Context, api API, kinds ...Kind) ([]Manifest, error) {
+	if len(kinds) == 0 {
+		kinds = ManifestKinds()
+	}
+	want := make(map[Kind]bool, len(kinds))
+	for _, k := range kinds {
+		if mk, ok := manifestKinds[k]; !ok || mk.parent != "" || mk.refOnly {
+			return nil, fmt.Errorf("unknown manifest kind %q", k)
+		}
+		want[k] = true
+	}
+	st, err := readLive(ctx, api, want)
+	if err != nil {
+		return nil, err
+	}
+	return st.manifests(want)
+}
+
+// DuplicateName describes resources which share their kind and name, and
+// their parent for nested resources.
+type DuplicateName struct {
+	Kind   Kind         `json:"kind"`
+	Name   string       `json:"name"`
+	Parent string       `json:"parent,omitempty"`
+	PKs    []PrimaryKey `json:"pks"`
+}
+
+func (d DuplicateName) String() string {
+	pks := make([]string, len(d.PKs))
+	for i, pk := range d.PKs {
+		pks[i] = fmt.Sprint(pk)
+	}
+	if d.Parent != "" {
+		return fmt.Sprintf("%s %q in %s %q is shared by %s", d.Kind, d.Name, manifestKinds[d.Kind].parent, d.Parent, strings.Join(pks, ", "))
+	}
+	return fmt.Sprintf("%s %q is shared by %s", d.Kind, d.Name, strings.Join(pks, ", "))
+}
+
+// DuplicateNamesError is returned by Export along with the manifests of the
+// other resources when some resources share their kind and name. It is
+// matched by ErrAmbiguous through errors.Is.
+type DuplicateNamesError struct {
+	Duplicates []DuplicateName
+}
+
+func (e *DuplicateNamesError) Error() string {
+	s := make([]string, len(e.Duplicates))
+	for i, d := range e.Duplicates {
+		s[i] = d.String()
+	}
+	return "left out resources with ambiguous names: " + strings.Join(s, "; ")
+}
+
+func (e *DuplicateNamesError) Is(target error) bool {
+	return target == ErrAmbiguous
+}
+
+// manifests returns the manifests of the top-level resources of the given
+// kinds, sorted by kind and name. The resources sharing their key with
+// another, top-level or nested, are left out and listed in a
+// *DuplicateNamesError returned along with the others.
+func (st *liveState) manifests(want map[Kind]bool) ([]Manifest, error) {
+	dups := st.duplicates(want)
+	skip := make(map[resourceKey]bool, len(dups))
+	for _, d := range dups {
+		skip[resourceKey{kind: d.Kind, parent: d.Parent, name: d.Name}] = true
+	}
+	var manifests []Manifest
+	for _, r := range st.resources {
+		if r.parent != nil || !want[r.kind] || skip[r.key()] {
+			continue
+		}
+		spec := r.spec
+		for _, ck := range childKinds(r.kind) {
+			mk := manifestKinds[ck]
+			items, _ := spec[mk.key].([]any)
+			kept := make([]any, 0, len(items))
+			for _, item := range items {
+				name, _ := item.(map[string]any)[mk.nameField].(string)
+				if !skip[resourceKey{kind: ck, parent: r.name, name: name}] {
+					kept = append(kept, item)
+				}
+			}
+			if len(kept) < len(items) {
+				spec = copyMap(spec)
+				spec[mk.key] = kept
+			}
+		}
+		manifests = append(manifests, Manifest{Kind: r.kind, Name: r.name, Spec: spec})
+	}
+	sort.SliceStable(manifests, func(i, j int) bool {
+		a, b := manifests[i], manifests[j]
+		if a.Kind != b.Kind {
+			return kindIndex(a.Kind) < kindIndex(b.Kind)
+		}
+		return a.Name < b.Name
+	})
+	if len(dups) > 0 {
+		return manifests, &DuplicateNamesError{Duplicates: dups}
+	}
+	return manifests, nil
+}
+
+// duplicates returns the resources sharing their key with another, among the
+// top-level resources of the given kinds and those nested in them. The
+// children of duplicates are not reported, as they are left out with them.
+func (st *liveState) duplicates(want map[Kind]bool) []DuplicateName {
+	var dups []DuplicateName
+	seen := make(map[resourceKey]bool)
+	for _, r := range st.resources {
+		top := r
+		for top.parent != nil {
+			top = top.parent
+		}
+		key := r.key()
+		if !want[top.kind] || manifestKinds[r.kind].refOnly || seen[key] || len(st.byKey[key]) < 2 {
+			continue
+		}
+		if r.parent != nil && len(st.byKey[r.parent.key()]) > 1 {
+			continue
+		}
+		seen[key] = true
+		d := DuplicateName{Kind: r.kind, Name: r.name, Parent: key.parent}
+		for _, f := range st.byKey[key] {
+			d.PKs = append(d.PKs, f.pk)
+		}
+		dups = append(dups, d)
+	}
+	sort.SliceStable(dups, func(i, j int) bool {
+		a, b := dups[i], dups[j]
+		if a.Kind != b.Kind {
+			return kindIndex(a.Kind) < kindIndex(b.Kind)
+		}
+		if a.Parent != b.Parent {
+			return a.Parent < b.Parent
+		}
+		return a.Name < b.Name
+	})
+	return dups
+}
+
+// WriteManifests writes each manifest to its own file in dir, named after its
+// kind and name: <dir>/<kind>/<name>.yaml. Names differing only by characters
+// which are not allowed in file names get a numeric suffix.
+func WriteManifests(dir string, manifests ...Manifest) error {
+	used := make(map[string]bool)
+	for _, m := range manifests {
+		kind := strings.ToLower(string(m.Kind))
+		base := slug(m.Name)
+		if base == "" {
+			base = kind
+		}
+		path := filepath.Join(dir, kind, base+".yaml")
+		for i := 2; used[path]; i++ {
+			path = filepath.Join(dir, kind, fmt.Sprintf("%s-%d.yaml", base, i))
+		}
+		used[path] = true
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		err = EncodeManifests(f, m)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// slug returns s in lower case, with every run of characters other than
+// letters and digits replaced by a dash.
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package upapi_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestExport(t *testing.T) {
+	_, api := newTestAccount(t, map[string]string{
+		"check-tags": `[{"pk": 1, "url": "https://uptime.com/api/v1/check-tags/1/", "tag": "prod", "color_hex": "#ff0000"}]`,
+		"checks": `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_interval": 5, "msp_address": "https://example.com",
+			"msp_uptime_sla": "99.9000", "msp_response_time_sla": "1.500", "state_is_up": true, "created_at": "2024-01-01T00:00:00Z", "stats_url": "https://uptime.com/x", "tags": ["prod"]}]`,
+		"credentials": `[{"id": 5, "display_name": "login", "credential_type": "BASIC"}]`,
+		"servicevariables": `[{"id": 40, "credential_id": 5, "credential": {"id": 5, "display_name": "login"},
+			"variable_name": "USER", "property_name": "username", "service": "web"}]`,
+		"sla-reports":           `[{"pk": 50, "url": "https://uptime.com/api/v1/sla-reports/50/", "name": "Monthly", "services_tags": ["prod"]}]`,
+		"sla-reports/50/groups": `[{"id": 51, "name": "Core", "group_services": ["web"]}]`,
+		"scheduled-reports":     `[{"pk": 60, "name": "Monthly mail", "sla_report": "https://uptime.com/api/v1/sla-reports/50/", "recurrence": "MONTHLY"}]`,
+		"users": `[{"pk": 2, "email": "owner@example.com", "is_primary": true, "access_level": "ADMIN"},
+			{"pk": 3, "email": "ann@example.com", "first_name": "Ann", "access_level": "READ_ONLY"}]`,
+		"statuspages":               `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components": `[{"pk": 71, "name": "Web", "service_id": 10, "group_id": null}]`,
+		"statuspages/70/subscribers": `[{"id": 75, "target": "b@example.com", "type": "EMAIL"},
+			{"id": 76, "target": "a@example.com", "type": "EMAIL"}]`,
+	})
+
+	manifests, err := upapi.Export(context.Background(), api)
+	require.NoError(t, err)
+	var names []string
+	for _, m := range manifests {
+		names = append(names, string(m.Kind)+" "+m.Name)
+	}
+	require.Equal(t, []string{
+		"Tag prod",
+		"User ann@example.com",
+		"User owner@example.com",
+		"Check web",
+		"SLAReport Monthly",
+		"ScheduledReport Monthly mail",
+		"StatusPage Public",
+	}, names)
+
+	require.Equal(t, map[string]any{
+		"check_type":            "HTTP",
+		"msp_interval":          float64(5),
+		"msp_address":           "https://example.com",
+		"msp_uptime_sla":        "99.9",
+		"msp_response_time_sla": "1.5",
+		"tags":                  []any{"prod"},
+		"service_variables": []any{
+			map[string]any{"variable_name": "USER", "property_name": "username", "credential": "login"},
+		},
+	}, manifests[3].Spec)
+	require.Equal(t, []any{map[string]any{"name": "Core", "group_services": []any{"web"}}}, manifests[4].Spec["reporting_groups"])
+	require.Equal(t, "Monthly", manifests[5].Spec["sla_report"])
+	page := manifests[6].Spec
+	require.Equal(t, "public", page["slug"])
+	require.Equal(t, []any{map[string]any{"name": "Web", "service": "web"}}, page["components"])
+	require.Equal(t, []any{
+		map[string]any{"target": "a@example.com", "type": "EMAIL", "force_validation_sms": false},
+		map[string]any{"target": "b@example.com", "type": "EMAIL", "force_validation_sms": false},
+	}, page["subscribers"])
+
+	_, err = upapi.Export(context.Background(), api, upapi.KindStatusPageComponent)
+	require.ErrorContains(t, err, `unknown manifest kind "StatusPageComponent"`)
+
+	// The exported files load back and match the account.
+	dir := t.TempDir()
+	require.NoError(t, upapi.WriteManifests(dir, manifests...))
+	_, err = os.Stat(filepath.Join(dir, "scheduledreport", "monthly-mail.yaml"))
+	require.NoError(t, err)
+	loaded, err := upapi.LoadManifests(dir)
+	require.NoError(t, err)
+	require.Len(t, loaded, len(manifests))
+	plan, err := upapi.PlanManifests(context.Background(), api, loaded, upapi.PlanOptions{Prune: true})
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), "%+v", plan.Changes)
+	require.Equal(t, len(manifests)+5, plan.Unchanged)
+}
+
+func TestWriteManifestsNames(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, upapi.WriteManifests(dir,
+		upapi.Manifest{Kind: upapi.KindCheck, Name: "API: prod"},
+		upapi.Manifest{Kind: upapi.KindCheck, Name: "api prod"},
+		upapi.Manifest{Kind: upapi.KindTag, Name: "!!"},
+	))
+	for _, path := range []string{"check/api-prod.yaml", "check/api-prod-2.yaml", "tag/tag.yaml"} {
+		_, err := os.Stat(filepath.Join(dir, path))
+		require.NoError(t, err, path)
+	}
+}
+
+func TestExportDuplicateNames(t *testing.T) {
+	_, api := newTestAccount(t, map[string]string{
+		"checks": `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_address": "https://example.com"},
+			{"pk": 11, "name": "web", "check_type": "ICMP", "msp_address": "example.com"},
+			{"pk": 12, "name": "api", "check_type": "HTTP", "msp_address": "https://api.example.com"}]`,
+		"statuspages": `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components": `[{"pk": 71, "name": "API", "service_id": 12},
+			{"pk": 72, "name": "Web", "service_id": 10}, {"pk": 73, "name": "Web", "service_id": 11}]`,
+	})
+
+	manifests, err := upapi.Export(context.Background(), api, upapi.KindCheck, upapi.KindStatusPage)
+	require.ErrorIs(t, err, upapi.ErrAmbiguous)
+	var dup *upapi.DuplicateNamesError
+	require.ErrorAs(t, err, &dup)
+	require.Equal(t, []upapi.DuplicateName{
+		{Kind: upapi.KindCheck, Name: "web", PKs: []upapi.PrimaryKey{10, 11}},
+		{Kind: upapi.KindStatusPageComponent, Name: "Web", Parent: "Public", PKs: []upapi.PrimaryKey{72, 73}},
+	}, dup.Duplicates)
+	require.ErrorContains(t, err, `Check "web" is shared by 10, 11`)
+
+	// The other resources are exported, without the duplicated components.
+	require.Len(t, manifests, 2)
+	require.Equal(t, "api", manifests[0].Name)
+	require.Equal(t, "Public", manifests[1].Name)
+	require.Equal(t, []any{map[string]any{"name": "API", "service": "api"}}, manifests[1].Spec["components"])
+}
@@ -0,0 +1,113 @@
+package upapi
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// maxLoggedBody bounds the size of request and response bodies attached to
+// log records.
+const maxLoggedBody = 64 << 10
+
+// WithLogger emits a structured record for every request and its response.
+//
+// Requests and successful responses are logged at debug level, responses with
+// an error status at warn level and transport errors at error level. Records
+// carry the method, URL, endpoint template, retry attempt, headers and
+// bodies. Credentials in headers and the fields tagged `redact:"true"` in the
+// request and response models of the endpoint called, such as passwords, API
+// keys and credential secrets, are masked before they reach the logger.
+func WithLogger(logger *slog.Logger) Option {
+	return func(cbd CBD) (CBD, error) {
+		return &withLoggerCBD{cbd, logger}, nil
+	}
+}
+
+type withLoggerCBD struct {
+	CBD
+	log *slog.Logger
+}
+
+func (l *withLoggerCBD) Do(rq *http.Request) (*http.Response, error) {
+	ctx := rq.Context()
+	attrs := []slog.Attr{
+		slog.String("method", rq.Method),
+		slog.String("url", rq.URL.String()),
+		slog.String("endpoint", EndpointTemplate(RequestEndpoint(rq))),
+	}
+	if attempt := RetryAttempt(ctx); attempt > 0 {
+		attrs = append(attrs, slog.Int("attempt", attempt))
+	}
+
+	if l.log.Enabled(ctx, slog.LevelDebug) {
+		body, err := requestBody(rq)
+		if err != nil {
+			return nil, err
+		}
+		l.log.LogAttrs(ctx, slog.LevelDebug, "upapi request", append(attrs,
+			slog.Any("headers", redactHeader(rq.Header)),
+			slog.String("body", loggedBody(rq, body)),
+		)...)
+	}
+
+	start := time.Now()
+	rs, err := l.CBD.Do(rq)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		l.log.LogAttrs(ctx, slog.LevelError, "upapi request failed", append(attrs, slog.String("error", err.Error()))...)
+		return nil, err
+	}
+
+	level := slog.LevelDebug
+	if rs.StatusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	if !l.log.Enabled(ctx, level) {
+		return rs, nil
+	}
+	body, err := io.ReadAll(rs.Body)
+	_ = rs.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rs.Body = io.NopCloser(bytes.NewReader(body))
+	l.log.LogAttrs(ctx, level, "upapi response", append(attrs,
+		slog.Int("status", rs.StatusCode),
+		slog.Any("headers", redactHeader(rs.Header)),
+		slog.String("body", loggedBody(rq, body)),
+	)...)
+	return rs, nil
+}
+
+// requestBody returns a copy of the request body, leaving rq able to send it.
+func requestBody(rq *http.Request) ([]byte, error) {
+	if rq.Body == nil || rq.Body == http.NoBody {
+		return nil, nil
+	}
+	if rq.GetBody != nil {
+		body, err := rq.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	buf, err := io.ReadAll(rq.Body)
+	_ = rq.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rq.Body = io.NopCloser(bytes.NewReader(buf))
+	return buf, nil
+}
+
+func loggedBody(rq *http.Request, body []byte) string {
+	body = bytes.TrimSpace(redactBody(redactedKeys(rq.Context()), body))
+	if len(body) > maxLoggedBody {
+		return string(body[:maxLoggedBody]) + "…"
+	}
+	return string(body)
+}
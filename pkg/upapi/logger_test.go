@@ -0,0 +1,170 @@
+package upapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rq map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&rq))
+		require.Equal(t, "hunter2", rq["msp_password"])
+		w.Header().Set("Set-Cookie", "sessionid=abc")
+		_, _ = w.Write([]byte(`{"results": {"pk": 1, "name": "web", "msp_password": "hunter2"}}`))
+	}))
+	defer srv.Close()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	api, err := New(
+		WithBaseURL(srv.URL+"/api/v1/"),
+		WithBearerToken("s3cr3t-token"),
+		WithLogger(logger),
+	)
+	require.NoError(t, err)
+
+	check, err := api.Checks().CreateHTTP(ctx, CheckHTTP{Name: "web", Password: "hunter2"})
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", check.Password)
+
+	out := buf.String()
+	require.NotContains(t, out, "s3cr3t-token")
+	require.NotContains(t, out, "hunter2")
+	require.NotContains(t, out, "sessionid")
+	require.Contains(t, out, "Bearer [REDACTED]")
+
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var rec map[string]any
+		require.NoError(t, dec.Decode(&rec))
+		records = append(records, rec)
+	}
+	require.Len(t, records, 2)
+	require.Equal(t, "upapi request", records[0]["msg"])
+	require.Equal(t, "POST", records[0]["method"])
+	require.Equal(t, "checks/add-http", records[0]["endpoint"])
+	require.Equal(t, "upapi response", records[1]["msg"])
+	require.Equal(t, float64(200), records[1]["status"])
+}
+
+func TestWithLoggerErrorStatus(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"messages": {"errors": true, "error_code": "VALIDATION_ERROR"}}`))
+	}))
+	defer srv.Close()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	api, err := New(WithBaseURL(srv.URL+"/api/v1/"), WithToken("tok"), WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = api.Checks().Get(ctx, PrimaryKey(1))
+	require.Error(t, err)
+
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	require.Equal(t, "WARN", rec["level"])
+	require.Equal(t, "checks/{pk}", rec["endpoint"])
+	require.Contains(t, rec["body"], "VALIDATION_ERROR")
+}
+
+func TestWithLoggerRedactsEndpointModels(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/integrations/3/":
+			_, _ = w.Write([]byte(`{"results": {"pk": 3, "module": "slack", "webhook_url": "https://hooks/k3y"}}`))
+		default:
+			// Tags have no secrets: a key named like one is left alone.
+			_, _ = w.Write([]byte(`{"count": 1, "results": [{"pk": 1, "tag": "prod", "api_key": "not-a-secret"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	api, err := New(WithBaseURL(srv.URL+"/api/v1/"), WithToken("tok"), WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = api.Integrations().Get(ctx, PrimaryKey(3))
+	require.NoError(t, err)
+	_, err = api.Tags().List(ctx, TagListOptions{})
+	require.NoError(t, err)
+
+	out := buf.String()
+	require.NotContains(t, out, "k3y")
+	require.Contains(t, out, "not-a-secret")
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		models  []reflect.Type
+		in, out string
+	}{
+		"credential": {
+			models: []reflect.Type{typeOf[Credential]()},
+			in:     `{"pk": 1, "credential_type": "BASIC", "secret": {"username": "u", "password": "p"}}`,
+			out:    `{"credential_type":"BASIC","pk":1,"secret":"[REDACTED]"}`,
+		},
+		"status page": {
+			models: []reflect.Type{typeOf[StatusPage]()},
+			in:     `{"name": "sp", "auth_username": "u", "auth_password": "p"}`,
+			out:    `{"auth_password":"[REDACTED]","auth_username":"u","name":"sp"}`,
+		},
+		"integrations": {
+			models: append(integrationModels(), typeOf[IntegrationListResponse]()),
+			in:     `{"results": [{"module": "opsgenie", "api_key": "k"}, {"module": "slack", "webhook_url": "https://hooks"}]}`,
+			out:    `{"results":[{"api_key":"[REDACTED]","module":"opsgenie"},{"module":"slack","webhook_url":"[REDACTED]"}]}`,
+		},
+		"other model": {
+			models: []reflect.Type{typeOf[Tag]()},
+			in:     `{"tag": "prod", "api_key": "k"}`,
+			out:    `{"tag": "prod", "api_key": "k"}`,
+		},
+		"empty secret": {
+			models: []reflect.Type{typeOf[Integration]()},
+			in:     `{"api_key": ""}`,
+			out:    `{"api_key": ""}`,
+		},
+		"not json": {
+			models: []reflect.Type{typeOf[Integration]()},
+			in:     `<html>Bad Gateway</html>`,
+			out:    `<html>Bad Gateway</html>`,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys := redactedKeys(withRedacted(context.Background(), c.models...))
+			require.Equal(t, c.out, string(bytes.TrimSpace(redactBody(keys, []byte(c.in)))))
+		})
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{
+		"Authorization": {"Token abc"},
+		"Cookie":        {"sessionid=abc"},
+		"Content-Type":  {"application/json"},
+	}
+	r := redactHeader(h)
+	require.Equal(t, "Token [REDACTED]", r.Get("Authorization"))
+	require.Equal(t, "[REDACTED]", r.Get("Cookie"))
+	require.Equal(t, "application/json", r.Get("Content-Type"))
+	require.Equal(t, "Token abc", h.Get("Authorization"))
+}
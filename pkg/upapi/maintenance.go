@@ -0,0 +1,234 @@
+package upapi
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MaintenanceWindow is a period during which a check is in maintenance.
+type MaintenanceWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Schedule is the index in CheckMaintenance.Schedule of the schedule the
+	// window comes from.
+	Schedule int `json:"schedule"`
+}
+
+// Contains reports whether t falls within the window, end excluded.
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// MaintenanceOverlap is a period covered by two windows at once.
+type MaintenanceOverlap struct {
+	Start time.Time         `json:"start"`
+	End   time.Time         `json:"end"`
+	A     MaintenanceWindow `json:"a"`
+	B     MaintenanceWindow `json:"b"`
+}
+
+// MaintenanceCalendar evaluates the schedules of a CheckMaintenance.
+//
+// Times of day and dates without an offset are read in the calendar's
+// location. Weekdays count from 0 for Monday to 6 for Sunday. A window whose
+// end time of day is not after its start time ends on the following day, and
+// monthdays past the end of a short month fall on its last day.
+//
+// A check whose state is ACTIVE is always in maintenance and one whose state
+// is SUSPENDED never is; neither has windows.
+type MaintenanceCalendar struct {
+	state     string
+	loc       *time.Location
+	schedules []maintenanceSchedule
+}
+
+type maintenanceSchedule struct {
+	CheckMaintenanceSchedule
+	index      int
+	from, to   time.Duration
+	start, end time.Time
+}
+
+// maintenanceHorizon bounds how far NextWindows looks ahead.
+const maintenanceHorizon = 10 * 366 * 24 * time.Hour
+
+// NewMaintenanceCalendar returns a calendar for m in loc, or the validation
+// error of m.
+func NewMaintenanceCalendar(m CheckMaintenance, loc *time.Location) (*MaintenanceCalendar, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	c := &MaintenanceCalendar{state: m.State, loc: loc}
+	for i, s := range m.Schedule {
+		ms := maintenanceSchedule{CheckMaintenanceSchedule: s, index: i}
+		var err error
+		if s.Type == "ONCE" {
+			if ms.start, err = parseMaintenanceDate(s.OnceStartDate, loc); err != nil {
+				return nil, err
+			}
+			if ms.end, err = parseMaintenanceDate(s.OnceEndDate, loc); err != nil {
+				return nil, err
+			}
+		} else {
+			if ms.from, err = parseMaintenanceClock(s.FromTime); err != nil {
+				return nil, err
+			}
+			if ms.to, err = parseMaintenanceClock(s.ToTime); err != nil {
+				return nil, err
+			}
+		}
+		c.schedules = append(c.schedules, ms)
+	}
+	return c, nil
+}
+
+func parseMaintenanceDate(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid maintenance date %q", s)
+}
+
+func parseMaintenanceClock(s string) (time.Duration, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid maintenance time %q", s)
+}
+
+// InMaintenance reports whether the check is in maintenance at t.
+func (c *MaintenanceCalendar) InMaintenance(t time.Time) bool {
+	switch c.state {
+	case "ACTIVE":
+		return true
+	case "SUSPENDED":
+		return false
+	}
+	return len(c.Windows(t, t.Add(time.Nanosecond))) > 0
+}
+
+// Windows returns the windows overlapping [from, to), ordered by start.
+func (c *MaintenanceCalendar) Windows(from, to time.Time) []MaintenanceWindow {
+	if c.state == "ACTIVE" || c.state == "SUSPENDED" {
+		return nil
+	}
+	var windows []MaintenanceWindow
+	add := func(w MaintenanceWindow) {
+		if w.End.After(from) && w.Start.Before(to) {
+			windows = append(windows, w)
+		}
+	}
+	// A monthly range may have started up to a month before from.
+	first := from.In(c.loc).AddDate(0, -1, -1)
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, c.loc)
+	for _, s := range c.schedules {
+		if s.Type == "ONCE" {
+			add(MaintenanceWindow{Start: s.start, End: s.end, Schedule: s.index})
+			continue
+		}
+		for day := first; day.Before(to); day = day.AddDate(0, 0, 1) {
+			if w, ok := s.startingOn(day, c.loc); ok {
+				add(w)
+			}
+		}
+	}
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+	return windows
+}
+
+// NextWindows returns up to n windows ending after t, the one in progress
+// at t included, ordered by start.
+func (c *MaintenanceCalendar) NextWindows(t time.Time, n int) []MaintenanceWindow {
+	var windows []MaintenanceWindow
+	for from := t; len(windows) < n && from.Sub(t) < maintenanceHorizon; from = from.AddDate(1, 0, 0) {
+		for _, w := range c.Windows(from, from.AddDate(1, 0, 0)) {
+			// Windows spanning both ranges were returned last time.
+			if from.After(t) && w.Start.Before(from) {
+				continue
+			}
+			windows = append(windows, w)
+		}
+	}
+	if len(windows) > n {
+		windows = windows[:n]
+	}
+	return windows
+}
+
+// Overlaps returns the periods of [from, to) during which a window of c and
+// a window of other coincide. Given c itself, it reports the overlaps between
+// different schedules of c.
+func (c *MaintenanceCalendar) Overlaps(other *MaintenanceCalendar, from, to time.Time) []MaintenanceOverlap {
+	a, b := c.Windows(from, to), other.Windows(from, to)
+	var overlaps []MaintenanceOverlap
+	for i, wa := range a {
+		for j, wb := range b {
+			if other == c && (j <= i || wa.Schedule == wb.Schedule) {
+				continue
+			}
+			start, end := wa.Start, wa.End
+			if wb.Start.After(start) {
+				start = wb.Start
+			}
+			if wb.End.Before(end) {
+				end = wb.End
+			}
+			if start.Before(end) {
+				overlaps = append(overlaps, MaintenanceOverlap{Start: start, End: end, A: wa, B: wb})
+			}
+		}
+	}
+	sort.SliceStable(overlaps, func(i, j int) bool {
+		return overlaps[i].Start.Before(overlaps[j].Start)
+	})
+	return overlaps
+}
+
+// startingOn returns the window of a recurring schedule starting on day, a
+// midnight in loc.
+func (s maintenanceSchedule) startingOn(day time.Time, loc *time.Location) (MaintenanceWindow, bool) {
+	endDay := day
+	switch s.Type {
+	case "DAILY":
+	case "WEEKLY":
+		weekday := (int(day.Weekday()) + 6) % 7
+		found := false
+		for _, d := range s.Weekdays {
+			found = found || d == weekday
+		}
+		if !found {
+			return MaintenanceWindow{}, false
+		}
+	case "MONTHLY":
+		from, to := s.Monthday, s.Monthday
+		if from == 0 {
+			from, to = s.MonthdayFrom, s.MonthdayTo
+		}
+		last := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, loc).Day()
+		if day.Day() != min(from, last) {
+			return MaintenanceWindow{}, false
+		}
+		endDay = day.AddDate(0, 0, min(to, last)-day.Day())
+	default:
+		return MaintenanceWindow{}, false
+	}
+	start := atClock(day, s.from, loc)
+	end := atClock(endDay, s.to, loc)
+	if !end.After(start) {
+		end = atClock(endDay.AddDate(0, 0, 1), s.to, loc)
+	}
+	return MaintenanceWindow{Start: start, End: end, Schedule: s.index}, true
+}
+
+// atClock returns the time of day d on day, built from the calendar fields so
+// that daylight saving changes keep the wall clock time.
+func atClock(day time.Time, d time.Duration, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second), 0, loc)
+}
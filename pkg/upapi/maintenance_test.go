@@ -0,0 +1,93 @@
+package upapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceCalendar(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	cal, err := NewMaintenanceCalendar(CheckMaintenance{
+		State: "SCHEDULED",
+		Schedule: []CheckMaintenanceSchedule{
+			{Type: "WEEKLY", Weekdays: []int{0, 4}, FromTime: "23:00", ToTime: "01:00"},
+			{Type: "MONTHLY", MonthdayFrom: 30, MonthdayTo: 31, FromTime: "22:00", ToTime: "06:00"},
+			{Type: "ONCE", OnceStartDate: "2026-03-02T00:30:00", OnceEndDate: "2026-03-02T02:00:00"},
+		},
+	}, loc)
+	require.NoError(t, err)
+
+	berlin := func(s string) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04", s, loc)
+		require.NoError(t, err)
+		return tm
+	}
+
+	// Friday 2026-02-27 23:00 to Saturday 01:00.
+	require.True(t, cal.InMaintenance(berlin("2026-02-28 00:30")))
+	require.False(t, cal.InMaintenance(berlin("2026-02-28 01:00")))
+
+	require.Equal(t, []string{
+		"2026-02-27 23:00 2026-02-28 01:00 #0",
+		// February has no 30th, the range starts on its last day.
+		"2026-02-28 22:00 2026-03-01 06:00 #1",
+		"2026-03-02 00:30 2026-03-02 02:00 #2",
+		"2026-03-02 23:00 2026-03-03 01:00 #0",
+	}, formatWindows(cal.NextWindows(berlin("2026-02-28 00:30"), 4), loc))
+
+	// Clocks go forward on 2026-03-29, windows keep their wall clock times.
+	require.Equal(t, []string{
+		"2026-03-27 23:00 2026-03-28 01:00 #0",
+		"2026-03-30 22:00 2026-03-31 06:00 #1",
+		"2026-03-30 23:00 2026-03-31 01:00 #0",
+	}, formatWindows(cal.Windows(berlin("2026-03-27 00:00"), berlin("2026-04-01 00:00")), loc))
+
+	overlaps := cal.Overlaps(cal, berlin("2026-03-01 00:00"), berlin("2026-04-01 00:00"))
+	require.Len(t, overlaps, 1)
+	require.Equal(t, berlin("2026-03-30 23:00"), overlaps[0].Start)
+	require.Equal(t, berlin("2026-03-31 01:00"), overlaps[0].End)
+	require.Equal(t, 1, overlaps[0].A.Schedule)
+	require.Equal(t, 0, overlaps[0].B.Schedule)
+}
+
+func TestMaintenanceCalendarState(t *testing.T) {
+	schedule := []CheckMaintenanceSchedule{{Type: "DAILY", FromTime: "02:00", ToTime: "03:00"}}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cal, err := NewMaintenanceCalendar(CheckMaintenance{State: "ACTIVE", Schedule: schedule}, time.UTC)
+	require.NoError(t, err)
+	require.True(t, cal.InMaintenance(now))
+	require.Empty(t, cal.NextWindows(now, 1))
+
+	cal, err = NewMaintenanceCalendar(CheckMaintenance{State: "SUSPENDED", Schedule: schedule}, time.UTC)
+	require.NoError(t, err)
+	require.False(t, cal.InMaintenance(now.Add(-9*time.Hour-30*time.Minute)))
+
+	cal, err = NewMaintenanceCalendar(CheckMaintenance{State: "SCHEDULED", Schedule: schedule}, time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-01-02 02:00 2026-01-02 03:00 #0"}, formatWindows(cal.NextWindows(now, 1), time.UTC))
+
+	other, err := NewMaintenanceCalendar(CheckMaintenance{State: "SCHEDULED", Schedule: []CheckMaintenanceSchedule{
+		{Type: "ONCE", OnceStartDate: "2026-01-05T02:30:00Z", OnceEndDate: "2026-01-05T04:00:00Z"},
+	}}, time.UTC)
+	require.NoError(t, err)
+	overlaps := cal.Overlaps(other, now, now.AddDate(0, 1, 0))
+	require.Len(t, overlaps, 1)
+	require.Equal(t, time.Date(2026, 1, 5, 2, 30, 0, 0, time.UTC), overlaps[0].Start)
+	require.Empty(t, cal.Overlaps(cal, now, now.AddDate(0, 1, 0)))
+
+	_, err = NewMaintenanceCalendar(CheckMaintenance{State: "SCHEDULED"}, time.UTC)
+	require.True(t, IsValidation(err))
+}
+
+func formatWindows(windows []MaintenanceWindow, loc *time.Location) []string {
+	var s []string
+	for _, w := range windows {
+		s = append(s, fmt.Sprintf("%s %s #%d", w.Start.In(loc).Format("2006-01-02 15:04"), w.End.In(loc).Format("2006-01-02 15:04"), w.Schedule))
+	}
+	return s
+}
@@ -0,0 +1,209 @@
+package upapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kind identifies the type of resource a Manifest describes.
+type Kind string
+
+const (
+	KindTag             Kind = "Tag"
+	KindUser            Kind = "User"
+	KindContact         Kind = "Contact"
+	KindIntegration     Kind = "Integration"
+	KindCheck           Kind = "Check"
+	KindDashboard       Kind = "Dashboard"
+	KindSLAReport       Kind = "SLAReport"
+	KindScheduledReport Kind = "ScheduledReport"
+	KindStatusPage      Kind = "StatusPage"
+
+	// The following kinds are not manifests of their own but listed in the
+	// spec of their parent: service variables under the service_variables key
+	// of their check, reporting groups under the reporting_groups key of their
+	// SLA report, and the rest under the key of the matching StatusPages
+	// endpoint.
+	KindServiceVariable         Kind = "ServiceVariable"
+	KindSLAReportGroup          Kind = "SLAReportGroup"
+	KindStatusPageComponent     Kind = "StatusPageComponent"
+	KindStatusPageMetric        Kind = "StatusPageMetric"
+	KindStatusPageIncident      Kind = "StatusPageIncident"
+	KindStatusPageSubscriber    Kind = "StatusPageSubscriber"
+	KindStatusPageAllowedDomain Kind = "StatusPageAllowedDomain"
+	KindStatusPageBlockedDomain Kind = "StatusPageBlockedDomain"
+	KindStatusPageUser          Kind = "StatusPageUser"
+)
+
+// ManifestKinds returns the kinds of manifests, in the order their resources
+// are created.
+func ManifestKinds() []Kind {
+	return childKinds("")
+}
+
+// Manifest describes the desired configuration of a resource.
+//
+// Spec holds the fields of the resource under their API JSON names, the name
+// excepted. References to other resources are made by name: components and
+// metrics refer to their check as service, components to their group as
+// group, incidents list their affected_components as {component, status}
+// pairs, service variables refer to their credential as credential, and
+// scheduled reports to their SLA report as sla_report. Server-managed fields
+// such as pk, url or created_at are ignored.
+type Manifest struct {
+	Kind Kind           `json:"kind" yaml:"kind"`
+	Name string         `json:"name" yaml:"name"`
+	Spec map[string]any `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+// LoadManifests reads the manifests in the given files and, recursively, in
+// the .yaml, .yml and .json files of the given directories. A file holds one
+// manifest, a list of manifests, or several YAML documents.
+func LoadManifests(paths ...string) ([]Manifest, error) {
+	var manifests []Manifest
+	seen := make(map[Kind]map[string]string)
+	load := func(path string) error {
+		ms, err := readManifestFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, m := range ms {
+			if seen[m.Kind] == nil {
+				seen[m.Kind] = make(map[string]string)
+			}
+			if prev, ok := seen[m.Kind][m.Name]; ok {
+				return fmt.Errorf("%s: %s %q is already defined in %s", path, m.Kind, m.Name, prev)
+			}
+			seen[m.Kind][m.Name] = path
+		}
+		manifests = append(manifests, ms...)
+		return nil
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if err := load(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".yaml", ".yml", ".json":
+				return load(p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return manifests, nil
+}
+
+func readManifestFile(path string) ([]Manifest, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var manifests []Manifest
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		node := &doc
+		if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+			node = node.Content[0]
+		}
+		var ms []Manifest
+		if node.Kind == yaml.SequenceNode {
+			err = node.Decode(&ms)
+		} else {
+			var m Manifest
+			err = node.Decode(&m)
+			ms = append(ms, m)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range ms {
+			if err := ms[i].normalize(); err != nil {
+				return nil, err
+			}
+		}
+		manifests = append(manifests, ms...)
+	}
+	return manifests, nil
+}
+
+// normalize checks the kind and name of m and turns its spec into the form
+// encoding/json produces, so that YAML integers and timestamps compare and
+// decode like API values.
+func (m *Manifest) normalize() error {
+	if mk, ok := manifestKinds[m.Kind]; !ok || mk.parent != "" || mk.refOnly {
+		return fmt.Errorf("unknown manifest kind %q", m.Kind)
+	}
+	if m.Name == "" {
+		return fmt.Errorf("%s manifest without a name", m.Kind)
+	}
+	spec, err := toMap(m.Spec)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", m.Kind, m.Name, err)
+	}
+	if spec == nil {
+		spec = map[string]any{}
+	}
+	m.Spec = spec
+	return nil
+}
+
+// EncodeManifests writes manifests to w as a stream of YAML documents.
+func EncodeManifests(w io.Writer, manifests ...Manifest) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	for _, m := range manifests {
+		if err := enc.Encode(m); err != nil {
+			return err
+		}
+	}
+	return enc.Close()
+}
+
+// toMap returns v as encoding/json would decode its JSON encoding into a map.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	err = json.Unmarshal(b, &m)
+	return m, err
+}
+
+// fromMap decodes m into v, which must be a pointer.
+func fromMap(m map[string]any, v any) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
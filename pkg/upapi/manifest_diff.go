@@ -0,0 +1,124 @@
+package upapi
+
+import (
+	"sort"
+)
+
+// DiffStatus tells how a resource differs between two sets of manifests.
+type DiffStatus string
+
+const (
+	// DiffAdded resources only have a manifest in the second set.
+	DiffAdded DiffStatus = "added"
+	// DiffRemoved resources only have a manifest in the first set.
+	DiffRemoved DiffStatus = "removed"
+	// DiffChanged resources have differing manifests in both sets.
+	DiffChanged DiffStatus = "changed"
+)
+
+// ResourceDiff is a resource whose manifests differ between two sets.
+type ResourceDiff struct {
+	Status DiffStatus `json:"status"`
+	Kind   Kind       `json:"kind"`
+	Name   string     `json:"name"`
+	// Parent is the name of the resource a nested resource belongs to.
+	Parent string `json:"parent,omitempty"`
+	// Diff lists the differing fields of a changed resource, Old being the
+	// value of the first set and New that of the second.
+	Diff []FieldDiff `json:"diff,omitempty"`
+}
+
+// DiffManifests compares two sets of manifests, such as the exports of two
+// accounts, and returns the resources which differ, ordered by kind, parent
+// and name. Resources are matched by kind and name, nested ones within their
+// parent. Server-managed fields and secrets are ignored, and so are the
+// differences PlanManifests ignores: a missing field matches a zero one and a
+// number matches its string form.
+func DiffManifests(from, to []Manifest) ([]ResourceDiff, error) {
+	a, err := expandManifests(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := expandManifests(to)
+	if err != nil {
+		return nil, err
+	}
+	specs := make(map[resourceKey]map[string]any, len(b))
+	for _, d := range b {
+		specs[d.key] = d.spec
+	}
+	diffs := []ResourceDiff{}
+	seen := make(map[resourceKey]bool, len(a))
+	for _, d := range a {
+		seen[d.key] = true
+		rd := ResourceDiff{Kind: d.key.kind, Name: d.key.name, Parent: d.key.parent}
+		spec, ok := specs[d.key]
+		if !ok {
+			rd.Status = DiffRemoved
+			diffs = append(diffs, rd)
+			continue
+		}
+		if rd.Diff = compareSpecs(d.key.kind, d.spec, spec); len(rd.Diff) > 0 {
+			rd.Status = DiffChanged
+			diffs = append(diffs, rd)
+		}
+	}
+	for _, d := range b {
+		if !seen[d.key] {
+			diffs = append(diffs, ResourceDiff{Status: DiffAdded, Kind: d.key.kind, Name: d.key.name, Parent: d.key.parent})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		x, y := diffs[i], diffs[j]
+		if x.Kind != y.Kind {
+			return kindIndex(x.Kind) < kindIndex(y.Kind)
+		}
+		if x.Parent != y.Parent {
+			return x.Parent < y.Parent
+		}
+		return x.Name < y.Name
+	})
+	return diffs, nil
+}
+
+// compareSpecs returns the fields differing between the specs a and b of a
+// resource of kind, both ways, unlike diffSpec which only looks at the fields
+// of the manifest.
+func compareSpecs(kind Kind, a, b map[string]any) []FieldDiff {
+	a, b = stripServerFields(copyMap(a)), stripServerFields(copyMap(b))
+	skip := make(map[string]bool)
+	for _, ck := range childKinds(kind) {
+		skip[manifestKinds[ck].key] = true
+	}
+	for _, f := range manifestKinds[kind].serverFields {
+		skip[f] = true
+	}
+	var diffs []FieldDiff
+	var walk func(prefix string, a, b map[string]any)
+	walk = func(prefix string, a, b map[string]any) {
+		keys := make(map[string]any, len(a)+len(b))
+		for k := range a {
+			keys[k] = nil
+		}
+		for k := range b {
+			keys[k] = nil
+		}
+		for _, k := range sortedKeys(keys) {
+			if prefix == "" && skip[k] || kindSecrets(kind)[k] {
+				continue
+			}
+			x, y := a[k], b[k]
+			xm, xIsMap := x.(map[string]any)
+			ym, yIsMap := y.(map[string]any)
+			if (xIsMap || x == nil) && (yIsMap || y == nil) && (xIsMap || yIsMap) {
+				walk(prefix+k+".", xm, ym)
+				continue
+			}
+			if !sameValue(x, y) || !sameValue(y, x) {
+				diffs = append(diffs, FieldDiff{Field: prefix + k, Old: x, New: y})
+			}
+		}
+	}
+	walk("", a, b)
+	return diffs
+}
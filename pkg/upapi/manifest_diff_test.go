@@ -0,0 +1,63 @@
+package upapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffManifests(t *testing.T) {
+	staging := []Manifest{
+		{Kind: KindTag, Name: "prod", Spec: map[string]any{"color_hex": "#ff0000"}},
+		{Kind: KindCheck, Name: "web", Spec: map[string]any{
+			"check_type":     "HTTP",
+			"msp_interval":   5,
+			"msp_uptime_sla": "99.9000",
+			"msp_password":   "staging-secret",
+			"msp_thresholds": map[string]any{"warn": 1},
+		}},
+		{Kind: KindCheck, Name: "old", Spec: map[string]any{"check_type": "DNS"}},
+		{Kind: KindStatusPage, Name: "Public", Spec: map[string]any{
+			"slug": "public",
+			"components": []any{
+				map[string]any{"name": "Web", "service": "web"},
+				map[string]any{"name": "Docs"},
+			},
+		}},
+	}
+	production := []Manifest{
+		{Kind: KindStatusPage, Name: "Public", Spec: map[string]any{
+			"slug":       "public",
+			"pk":         7,
+			"components": []any{map[string]any{"name": "Web", "service": "web", "is_group": false}},
+		}},
+		{Kind: KindCheck, Name: "web", Spec: map[string]any{
+			"check_type":     "HTTP",
+			"msp_interval":   1,
+			"msp_uptime_sla": 99.9,
+			"msp_password":   "prod-secret",
+			"msp_thresholds": map[string]any{"warn": 1, "crit": 2},
+		}},
+		{Kind: KindTag, Name: "prod", Spec: map[string]any{"color_hex": "#ff0000"}},
+		{Kind: KindTag, Name: "new"},
+	}
+
+	diffs, err := DiffManifests(staging, production)
+	require.NoError(t, err)
+	require.Equal(t, []ResourceDiff{
+		{Status: DiffAdded, Kind: KindTag, Name: "new"},
+		{Status: DiffRemoved, Kind: KindCheck, Name: "old"},
+		{Status: DiffChanged, Kind: KindCheck, Name: "web", Diff: []FieldDiff{
+			{Field: "msp_interval", Old: float64(5), New: float64(1)},
+			{Field: "msp_thresholds.crit", Old: nil, New: float64(2)},
+		}},
+		{Status: DiffRemoved, Kind: KindStatusPageComponent, Name: "Docs", Parent: "Public"},
+	}, diffs)
+
+	diffs, err = DiffManifests(production, production)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	_, err = DiffManifests([]Manifest{{Kind: KindTag, Name: "a"}, {Kind: KindTag, Name: "a"}}, nil)
+	require.ErrorContains(t, err, `Tag "a" has several manifests`)
+}
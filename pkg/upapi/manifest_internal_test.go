@@ -0,0 +1,28 @@
+package upapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSameValue(t *testing.T) {
+	require.True(t, sameValue(nil, false))
+	require.True(t, sameValue(false, nil))
+	require.True(t, sameValue([]any{}, nil))
+	require.True(t, sameValue(float64(99.9), "99.9"))
+	require.True(t, sameValue("99.9", float64(99.9)))
+	require.True(t, sameValue(map[string]any{"a": float64(1)}, map[string]any{"a": float64(1), "b": "x"}))
+	require.False(t, sameValue(map[string]any{"a": float64(1)}, map[string]any{"a": float64(2)}))
+	require.False(t, sameValue([]any{"a"}, []any{"a", "b"}))
+	require.False(t, sameValue("x", nil))
+}
+
+func TestManifestKindSecrets(t *testing.T) {
+	for k, mk := range manifestKinds {
+		require.NotNil(t, mk.model, "kind %s has no model", k)
+	}
+	require.True(t, kindSecrets(kindCredential)["secret"])
+	require.True(t, kindSecrets(KindIntegration)["webhook_url"])
+	require.Empty(t, kindSecrets(KindTag))
+}
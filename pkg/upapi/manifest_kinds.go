@@ -0,0 +1,585 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// manifestKind describes how resources of a kind are listed, converted
+// between their API and manifest forms, and changed.
+type manifestKind struct {
+	// parent is the kind the resources are nested in, and key the field of
+	// the parent's spec listing them.
+	parent Kind
+	key    string
+	// nameField is the API field holding the name of a resource.
+	nameField string
+	// serverFields lists the fields the server sets, besides the
+	// serverManagedFields common to every kind.
+	serverFields []string
+	// model is the API form of the resources, whose fields tagged redact are
+	// secrets the API does not return and manifests leave out.
+	model any
+	// refers lists the kinds the resources refer to by primary key or URL,
+	// which are read along to express the references by name.
+	refers []Kind
+	// refOnly kinds are only read to resolve references to them.
+	refOnly bool
+
+	// list returns the resources nested in parent, or every resource of a
+	// top-level kind. Nested kinds whose endpoint is not, such as service
+	// variables, are listed at once by listAll and attached to the parent
+	// parentOf returns.
+	list     func(ctx context.Context, api API, parent PrimaryKey) ([]map[string]any, error)
+	listAll  func(ctx context.Context, api API) ([]map[string]any, error)
+	parentOf func(st *liveState, raw map[string]any) *liveResource
+	// create returns the API form of the created resource. Kinds without
+	// update are updated by deleting and creating the resource again.
+	create func(ctx context.Context, api API, parent PrimaryKey, raw map[string]any) (map[string]any, error)
+	update func(ctx context.Context, api API, parent, pk PrimaryKey, raw map[string]any) error
+	delete func(ctx context.Context, api API, parent, pk PrimaryKey) error
+}
+
+// manifestOrder lists the kinds in the order their resources are created:
+// a resource only refers to resources of the kinds before its own. Deletions
+// happen in the reverse order.
+var manifestOrder = []Kind{
+	kindCredential,
+	KindTag,
+	KindUser,
+	KindContact,
+	KindIntegration,
+	KindCheck,
+	KindServiceVariable,
+	KindDashboard,
+	KindSLAReport,
+	KindSLAReportGroup,
+	KindScheduledReport,
+	KindStatusPage,
+	KindStatusPageComponent,
+	KindStatusPageMetric,
+	KindStatusPageIncident,
+	KindStatusPageSubscriber,
+	KindStatusPageAllowedDomain,
+	KindStatusPageBlockedDomain,
+	KindStatusPageUser,
+}
+
+// kindCredential is only read for service variables to refer to credentials
+// by name; credentials hold secrets and are not managed through manifests.
+const kindCredential Kind = "Credential"
+
+// serverManagedFields are set by the server on every kind of resource, at any
+// depth, and never part of a manifest. So is any field prefixed with state_.
+var serverManagedFields = []string{"pk", "url", "stats_url", "alerts_url", "created_at", "modified_at", "cached_response_time"}
+
+var manifestKinds = map[Kind]manifestKind{
+	KindTag: {
+		nameField: "tag",
+		model:     Tag{},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Tags(), TagListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.Tags().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.Tags().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Tags().Delete(ctx, pk)
+		},
+	},
+	KindContact: {
+		nameField: "name",
+		model:     Contact{},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Contacts(), ContactListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.Contacts().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.Contacts().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Contacts().Delete(ctx, pk)
+		},
+	},
+	KindIntegration: {
+		nameField:    "name",
+		model:        Integration{},
+		serverFields: []string{"is_errored", "last_error"},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Integrations(), IntegrationListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			m, err := integrationModuleOf(raw["module"])
+			if err != nil {
+				return nil, err
+			}
+			return m.create(ctx, api.Integrations(), raw)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			m, err := integrationModuleOf(raw["module"])
+			if err != nil {
+				return err
+			}
+			return m.update(ctx, api.Integrations(), pk, raw)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Integrations().Delete(ctx, pk)
+		},
+	},
+	KindCheck: {
+		nameField:    "name",
+		model:        Check{},
+		serverFields: []string{"monitoring_service_type", "is_under_maintenance", "heartbeat_url", "webhook_url"},
+		refers:       []Kind{kindCredential},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAllChecks(ctx, api.Checks(), CheckListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return saveCheck(ctx, api, nil, raw)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			_, err := saveCheck(ctx, api, pk, raw)
+			return err
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Checks().Delete(ctx, pk)
+		},
+	},
+	KindDashboard: {
+		nameField: "name",
+		model:     Dashboard{},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Dashboards(), DashboardListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.Dashboards().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.Dashboards().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Dashboards().Delete(ctx, pk)
+		},
+	},
+	KindSLAReport: {
+		nameField: "name",
+		model:     SLAReport{},
+		refers:    []Kind{KindCheck},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.SLAReports(), SLAReportListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.SLAReports().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.SLAReports().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.SLAReports().Delete(ctx, pk)
+		},
+	},
+	KindStatusPage: {
+		nameField: "name",
+		model:     StatusPage{},
+		refers:    []Kind{KindCheck},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages(), StatusPageListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.StatusPages().Delete(ctx, pk)
+		},
+	},
+	KindStatusPageComponent: {
+		parent:    KindStatusPage,
+		key:       "components",
+		nameField: "name",
+		model:     StatusPageComponent{},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().Components(page), StatusPageComponentListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Components(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().Components(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().Components(page).Delete(ctx, pk)
+		},
+	},
+	KindStatusPageMetric: {
+		parent:    KindStatusPage,
+		key:       "metrics",
+		nameField: "name",
+		model:     StatusPageMetric{},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().Metrics(page), StatusPageMetricListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Metrics(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().Metrics(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().Metrics(page).Delete(ctx, pk)
+		},
+	},
+	KindStatusPageIncident: {
+		parent:    KindStatusPage,
+		key:       "incidents",
+		nameField: "name",
+		model:     StatusPageIncident{},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().Incidents(page), StatusPageIncidentListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Incidents(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().Incidents(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().Incidents(page).Delete(ctx, pk)
+		},
+	},
+	kindCredential: {
+		nameField: "display_name",
+		model:     Credential{},
+		refOnly:   true,
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Credentials(), CredentialListOptions{}))
+		},
+	},
+	KindUser: {
+		nameField:    "email",
+		model:        User{},
+		serverFields: []string{"is_primary", "must_two_factor", "account"},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.Users(), UserListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.Users().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.Users().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.Users().Delete(ctx, pk)
+		},
+	},
+	KindServiceVariable: {
+		parent:       KindCheck,
+		key:          "service_variables",
+		nameField:    "variable_name",
+		model:        ServiceVariable{},
+		serverFields: []string{"id", "service", "deleted_at", "account"},
+		listAll: func(ctx context.Context, api API) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.ServiceVariables(), ServiceVariableListOptions{}))
+		},
+		// The API names the check of a variable in service, by name, URL or
+		// primary key.
+		parentOf: func(st *liveState, raw map[string]any) *liveResource {
+			service := fmt.Sprint(raw["service"])
+			for _, r := range st.resources {
+				if r.kind == KindCheck && (r.name == service || r.raw["url"] == service || fmt.Sprint(r.pk) == service) {
+					return r
+				}
+			}
+			return nil
+		},
+		create: func(ctx context.Context, api API, check PrimaryKey, raw map[string]any) (map[string]any, error) {
+			raw["service_id"] = check
+			return createWith(ctx, raw, api.ServiceVariables().Create)
+		},
+		update: func(ctx context.Context, api API, check, pk PrimaryKey, raw map[string]any) error {
+			raw["service_id"] = check
+			return updateWith(ctx, pk, raw, api.ServiceVariables().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.ServiceVariables().Delete(ctx, pk)
+		},
+	},
+	KindSLAReportGroup: {
+		parent:       KindSLAReport,
+		key:          "reporting_groups",
+		nameField:    "name",
+		model:        SLAReportGroup{},
+		serverFields: []string{"id"},
+		list: func(ctx context.Context, api API, report PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.SLAReports().ReportingGroups(report), SLAReportGroupListOptions{}))
+		},
+		create: func(ctx context.Context, api API, report PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.SLAReports().ReportingGroups(report).Create)
+		},
+		delete: func(ctx context.Context, api API, report, pk PrimaryKey) error {
+			return api.SLAReports().ReportingGroups(report).Delete(ctx, pk)
+		},
+	},
+	KindScheduledReport: {
+		nameField: "name",
+		model:     ScheduledReport{},
+		refers:    []Kind{KindSLAReport},
+		list: func(ctx context.Context, api API, _ PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.ScheduledReports(), ScheduledReportListOptions{}))
+		},
+		create: func(ctx context.Context, api API, _ PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.ScheduledReports().Create)
+		},
+		update: func(ctx context.Context, api API, _, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.ScheduledReports().Update)
+		},
+		delete: func(ctx context.Context, api API, _, pk PrimaryKey) error {
+			return api.ScheduledReports().Delete(ctx, pk)
+		},
+	},
+	KindStatusPageSubscriber: {
+		parent:       KindStatusPage,
+		key:          "subscribers",
+		nameField:    "target",
+		model:        StatusPageSubscriber{},
+		serverFields: []string{"id"},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().Subscribers(page), StatusPageSubscriberListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Subscribers(page).Create)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().Subscribers(page).Delete(ctx, pk)
+		},
+	},
+	KindStatusPageAllowedDomain: {
+		parent:       KindStatusPage,
+		key:          "subscription_domain_allow_list",
+		nameField:    "domain",
+		model:        StatusPageSubsDomainAllowList{},
+		serverFields: []string{"id"},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().SubscriptionDomainAllowList(page), StatusPageSubsDomainAllowListListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().SubscriptionDomainAllowList(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().SubscriptionDomainAllowList(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().SubscriptionDomainAllowList(page).Delete(ctx, pk)
+		},
+	},
+	KindStatusPageBlockedDomain: {
+		parent:       KindStatusPage,
+		key:          "subscription_domain_block_list",
+		nameField:    "domain",
+		model:        StatusPageSubsDomainBlockList{},
+		serverFields: []string{"id"},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().SubscriptionDomainBlockList(page), StatusPageSubsDomainBlockListListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().SubscriptionDomainBlockList(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().SubscriptionDomainBlockList(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().SubscriptionDomainBlockList(page).Delete(ctx, pk)
+		},
+	},
+	KindStatusPageUser: {
+		parent:    KindStatusPage,
+		key:       "users",
+		nameField: "email",
+		model:     StatusPageUser{},
+		list: func(ctx context.Context, api API, page PrimaryKey) ([]map[string]any, error) {
+			return listMaps(ListAll(ctx, api.StatusPages().Users(page), StatusPageUserListOptions{}))
+		},
+		create: func(ctx context.Context, api API, page PrimaryKey, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, api.StatusPages().Users(page).Create)
+		},
+		update: func(ctx context.Context, api API, page, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, api.StatusPages().Users(page).Update)
+		},
+		delete: func(ctx context.Context, api API, page, pk PrimaryKey) error {
+			return api.StatusPages().Users(page).Delete(ctx, pk)
+		},
+	},
+}
+
+// kindSecrets returns the JSON names of the secret fields of kind, including
+// those of every integration module for integrations.
+func kindSecrets(kind Kind) map[string]bool {
+	return manifestSecrets()[kind]
+}
+
+var manifestSecrets = sync.OnceValue(func() map[Kind]map[string]bool {
+	secrets := make(map[Kind]map[string]bool, len(manifestKinds))
+	for k, mk := range manifestKinds {
+		types := []reflect.Type{reflect.TypeOf(mk.model)}
+		if k == KindIntegration {
+			types = append(types, integrationModels()...)
+		}
+		keys := make(map[string]bool)
+		for _, t := range types {
+			for f := range sensitiveKeysOf(t) {
+				keys[f] = true
+			}
+		}
+		secrets[k] = keys
+	}
+	return secrets
+})
+
+// childKinds returns the kinds nested in parent, in creation order.
+func childKinds(parent Kind) []Kind {
+	var kinds []Kind
+	for _, k := range manifestOrder {
+		if mk := manifestKinds[k]; mk.parent == parent && !mk.refOnly {
+			kinds = append(kinds, k)
+		}
+	}
+	return kinds
+}
+
+func listMaps[T any](items []T, err error) ([]map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+	maps := make([]map[string]any, len(items))
+	for i := range items {
+		if maps[i], err = toMap(items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return maps, nil
+}
+
+func createWith[T any, R any](ctx context.Context, raw map[string]any, create func(context.Context, T) (*R, error)) (map[string]any, error) {
+	var v T
+	if err := fromMap(raw, &v); err != nil {
+		return nil, err
+	}
+	created, err := create(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return toMap(created)
+}
+
+func updateWith[T any, R any](ctx context.Context, pk PrimaryKey, raw map[string]any, update func(context.Context, PrimaryKeyable, T) (*R, error)) error {
+	var v T
+	if err := fromMap(raw, &v); err != nil {
+		return err
+	}
+	_, err := update(ctx, pk, v)
+	return err
+}
+
+// saveCheck creates the check described by raw, or updates the check pk when
+// not nil, through the request struct matching its check_type.
+func saveCheck(ctx context.Context, api API, pk PrimaryKeyable, raw map[string]any) (map[string]any, error) {
+	var c Check
+	if err := fromMap(raw, &c); err != nil {
+		return nil, err
+	}
+	req, err := c.ToRequest()
+	if err != nil {
+		return nil, err
+	}
+	saved, err := api.Checks().Save(ctx, req, CheckSaveOptions{PK: pk})
+	if err != nil {
+		return nil, err
+	}
+	return toMap(saved)
+}
+
+// integrationModule creates and updates the integrations of a module through
+// the matching IntegrationsEndpoint methods.
+type integrationModule struct {
+	create func(context.Context, IntegrationsEndpoint, map[string]any) (map[string]any, error)
+	update func(context.Context, IntegrationsEndpoint, PrimaryKey, map[string]any) error
+	// secrets lists the JSON names of the fields tagged redact, which the
+	// API does not return.
+	secrets []string
+	// fields keeps the fields of raw the module's request struct has.
+	fields func(raw map[string]any) (map[string]any, error)
+}
+
+func newIntegrationModule[T any](
+	create func(IntegrationsEndpoint, context.Context, T) (*Integration, error),
+	update func(IntegrationsEndpoint, context.Context, PrimaryKeyable, T) (*Integration, error),
+) integrationModule {
+	return integrationModule{
+		secrets: sortedKeys(sensitiveKeysOf(typeOf[T]())),
+		fields: func(raw map[string]any) (map[string]any, error) {
+			var v T
+			if err := fromMap(raw, &v); err != nil {
+				return nil, err
+			}
+			return toMap(v)
+		},
+		create: func(ctx context.Context, ep IntegrationsEndpoint, raw map[string]any) (map[string]any, error) {
+			return createWith(ctx, raw, func(ctx context.Context, v T) (*Integration, error) {
+				return create(ep, ctx, v)
+			})
+		},
+		update: func(ctx context.Context, ep IntegrationsEndpoint, pk PrimaryKey, raw map[string]any) error {
+			return updateWith(ctx, pk, raw, func(ctx context.Context, pk PrimaryKeyable, v T) (*Integration, error) {
+				return update(ep, ctx, pk, v)
+			})
+		},
+	}
+}
+
+// integrationModules is keyed by module name, lower case and without
+// separators.
+var integrationModules = map[string]integrationModule{
+	"cachet":          newIntegrationModule(IntegrationsEndpoint.CreateCachet, IntegrationsEndpoint.UpdateCachet),
+	"datadog":         newIntegrationModule(IntegrationsEndpoint.CreateDatadog, IntegrationsEndpoint.UpdateDatadog),
+	"geckoboard":      newIntegrationModule(IntegrationsEndpoint.CreateGeckoboard, IntegrationsEndpoint.UpdateGeckoboard),
+	"jiraservicedesk": newIntegrationModule(IntegrationsEndpoint.CreateJiraServicedesk, IntegrationsEndpoint.UpdateJiraServiceDesk),
+	"klipfolio":       newIntegrationModule(IntegrationsEndpoint.CreateKlipfolio, IntegrationsEndpoint.UpdateKlipfolio),
+	"librato":         newIntegrationModule(IntegrationsEndpoint.CreateLibrato, IntegrationsEndpoint.UpdateLibrato),
+	"microsoftteams":  newIntegrationModule(IntegrationsEndpoint.CreateMicrosoftTeams, IntegrationsEndpoint.UpdateMicrosoftTeams),
+	"opsgenie":        newIntegrationModule(IntegrationsEndpoint.CreateOpsgenie, IntegrationsEndpoint.UpdateOpsgenie),
+	"pagerduty":       newIntegrationModule(IntegrationsEndpoint.CreatePagerduty, IntegrationsEndpoint.UpdatePagerduty),
+	"pushbullet":      newIntegrationModule(IntegrationsEndpoint.CreatePushbullet, IntegrationsEndpoint.UpdatePushbullet),
+	"pushover":        newIntegrationModule(IntegrationsEndpoint.CreatePushover, IntegrationsEndpoint.UpdatePushover),
+	"slack":           newIntegrationModule(IntegrationsEndpoint.CreateSlack, IntegrationsEndpoint.UpdateSlack),
+	"status":          newIntegrationModule(IntegrationsEndpoint.CreateStatus, IntegrationsEndpoint.UpdateStatus),
+	"statuspage":      newIntegrationModule(IntegrationsEndpoint.CreateStatuspage, IntegrationsEndpoint.UpdateStatuspage),
+	"twitter":         newIntegrationModule(IntegrationsEndpoint.CreateTwitter, IntegrationsEndpoint.UpdateTwitter),
+	"victorops":       newIntegrationModule(IntegrationsEndpoint.CreateVictorops, IntegrationsEndpoint.UpdateVictorops),
+	"wavefront":       newIntegrationModule(IntegrationsEndpoint.CreateWavefront, IntegrationsEndpoint.UpdateWavefront),
+	"webhook":         newIntegrationModule(IntegrationsEndpoint.CreateWebhook, IntegrationsEndpoint.UpdateWebhook),
+	"zapier":          newIntegrationModule(IntegrationsEndpoint.CreateZapier, IntegrationsEndpoint.UpdateZapier),
+}
+
+var integrationModuleReplacer = strings.NewReplacer("-", "", "_", "", " ", "")
+
+func integrationModuleOf(module any) (integrationModule, error) {
+	name, _ := module.(string)
+	if name == "" {
+		return integrationModule{}, fmt.Errorf("integration without a module")
+	}
+	m, ok := integrationModules[integrationModuleReplacer.Replace(strings.ToLower(name))]
+	if !ok {
+		return m, fmt.Errorf("unsupported integration module %q", name)
+	}
+	return m, nil
+}
@@ -0,0 +1,427 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ChangeAction is what applying a Change does to a resource.
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+// FieldDiff is a field of a resource whose live value, Old, differs from the
+// value New of its manifest. Fields of nested objects are dotted.
+type FieldDiff struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// Change is a step of a Plan.
+type Change struct {
+	Action ChangeAction `json:"action"`
+	Kind   Kind         `json:"kind"`
+	Name   string       `json:"name"`
+	// Parent is the name of the resource a nested resource belongs to, such
+	// as the status page of a component.
+	Parent string `json:"parent,omitempty"`
+	// PK is the primary key of the resource updated or deleted.
+	PK PrimaryKey `json:"pk,omitempty"`
+	// Diff lists the fields changed. Those of a created resource have no Old
+	// value, and those of a deleted one no New value.
+	Diff []FieldDiff `json:"diff,omitempty"`
+
+	spec map[string]any
+	live *liveResource
+}
+
+// Plan lists the changes bringing an account in line with a set of
+// manifests, in the order ApplyPlan makes them.
+type Plan struct {
+	Changes []Change `json:"changes"`
+	// Unchanged counts the resources matching their manifest.
+	Unchanged int `json:"unchanged"`
+	// Skipped lists the resources a migration leaves out because they share
+	// their kind and name with another.
+	Skipped []DuplicateName `json:"skipped,omitempty"`
+
+	state *liveState
+}
+
+// Empty reports whether the account already matches the manifests.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// PlanOptions specifies the optional parameters to PlanManifests.
+type PlanOptions struct {
+	// Prune deletes the resources without a manifest, of the kinds the
+	// manifests describe. Nested resources are pruned from the parents whose
+	// manifest lists them, and the primary user is never deleted.
+	Prune bool
+}
+
+// desiredResource is a resource described by a manifest, or nested in the
+// spec of one.
+type desiredResource struct {
+	key  resourceKey
+	spec map[string]any
+}
+
+// PlanManifests compares manifests with the live account and returns the
+// changes needed for the account to match them. Resources are matched by
+// kind and name, and only the fields a manifest sets are compared. Secrets,
+// which the API does not return, are sent on creation and along other
+// changes but never reported as a difference.
+func PlanManifests(ctx context.Context, api API, manifests []Manifest, opts PlanOptions) (*Plan, error) {
+	desired, err := expandManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+	kinds := make(map[Kind]bool)
+	// managed holds the parents whose manifest lists each nested kind.
+	managed := make(map[resourceKey]bool)
+	wanted := make(map[resourceKey]bool, len(desired))
+	for _, d := range desired {
+		kinds[d.key.kind] = true
+		wanted[d.key] = true
+		for _, ck := range childKinds(d.key.kind) {
+			if _, ok := d.spec[manifestKinds[ck].key]; ok {
+				managed[resourceKey{kind: ck, name: d.key.name}] = true
+			}
+		}
+	}
+	st, err := readLive(ctx, api, kinds)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{Changes: []Change{}, state: st}
+	for _, d := range desired {
+		for _, ref := range specRefs(d.key.kind, d.key.parent, d.spec) {
+			if _, ok := st.pks[ref]; !ok && !wanted[ref] {
+				return nil, fmt.Errorf("%s %q refers to %s %q, which neither exists nor has a manifest", d.key.kind, d.key.name, ref.kind, ref.name)
+			}
+		}
+		change := Change{Kind: d.key.kind, Name: d.key.name, Parent: d.key.parent, spec: d.spec}
+		live, err := st.lookup(d.key.kind, d.key.parent, d.key.name)
+		if err != nil {
+			return nil, err
+		}
+		if live == nil {
+			change.Action = ChangeCreate
+			change.Diff = compareSpecs(d.key.kind, nil, d.spec)
+			plan.Changes = append(plan.Changes, change)
+			continue
+		}
+		if err := checkImmutable(d.key.kind, live, d.spec); err != nil {
+			return nil, err
+		}
+		change.PK, change.live = live.pk, live
+		change.Diff = diffSpec(d.key.kind, d.spec, live.spec)
+		if len(change.Diff) == 0 {
+			plan.Unchanged++
+			continue
+		}
+		change.Action = ChangeUpdate
+		plan.Changes = append(plan.Changes, change)
+	}
+	if opts.Prune {
+		var deletes []Change
+		for _, r := range st.resources {
+			key := r.key()
+			if wanted[key] || manifestKinds[r.kind].refOnly {
+				continue
+			}
+			if r.parent == nil && !kinds[r.kind] {
+				continue
+			}
+			// Nested resources of a pruned parent go along with it.
+			if r.parent != nil && !managed[resourceKey{kind: r.kind, name: key.parent}] {
+				continue
+			}
+			if isPrimary, _ := r.raw["is_primary"].(bool); r.kind == KindUser && isPrimary {
+				continue
+			}
+			deletes = append(deletes, Change{
+				Action: ChangeDelete, Kind: r.kind, Name: r.name, Parent: key.parent, PK: r.pk,
+				Diff: compareSpecs(r.kind, r.spec, nil), live: r,
+			})
+		}
+		sort.SliceStable(deletes, func(i, j int) bool {
+			return kindIndex(deletes[i].Kind) > kindIndex(deletes[j].Kind)
+		})
+		plan.Changes = append(plan.Changes, deletes...)
+	}
+	return plan, nil
+}
+
+// expandManifests returns the resources described by manifests, the nested
+// ones included, in creation order.
+func expandManifests(manifests []Manifest) ([]desiredResource, error) {
+	var desired []desiredResource
+	seen := make(map[resourceKey]bool)
+	add := func(d desiredResource) error {
+		if seen[d.key] {
+			if d.key.parent != "" {
+				return fmt.Errorf("%s %q is listed twice in %s %q", d.key.kind, d.key.name, manifestKinds[d.key.kind].parent, d.key.parent)
+			}
+			return fmt.Errorf("%s %q has several manifests", d.key.kind, d.key.name)
+		}
+		seen[d.key] = true
+		desired = append(desired, d)
+		return nil
+	}
+	for _, m := range manifests {
+		if err := m.normalize(); err != nil {
+			return nil, err
+		}
+		if err := add(desiredResource{key: resourceKey{kind: m.Kind, name: m.Name}, spec: m.Spec}); err != nil {
+			return nil, err
+		}
+		for _, ck := range childKinds(m.Kind) {
+			mk := manifestKinds[ck]
+			key := mk.key
+			items, ok := m.Spec[key].([]any)
+			if !ok && m.Spec[key] != nil {
+				return nil, fmt.Errorf("%s %q: %s must be a list", m.Kind, m.Name, key)
+			}
+			for _, item := range items {
+				spec, _ := item.(map[string]any)
+				name, _ := spec[mk.nameField].(string)
+				if name == "" {
+					return nil, fmt.Errorf("%s %q: %s without %s", m.Kind, m.Name, ck, mk.nameField)
+				}
+				spec = copyMap(spec)
+				delete(spec, mk.nameField)
+				if err := add(desiredResource{key: resourceKey{kind: ck, parent: m.Name, name: name}, spec: spec}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	sort.SliceStable(desired, func(i, j int) bool {
+		return createRank(desired[i]) < createRank(desired[j])
+	})
+	return desired, nil
+}
+
+// createRank orders resources by kind, then creates group checks and
+// component groups before the checks and components they refer to.
+func createRank(d desiredResource) int {
+	rank := 2 * kindIndex(d.key.kind)
+	switch d.key.kind {
+	case KindCheck:
+		if d.spec["check_type"] == "GROUP" {
+			rank++
+		}
+	case KindStatusPageComponent:
+		if isGroup, _ := d.spec["is_group"].(bool); !isGroup {
+			rank++
+		}
+	}
+	return rank
+}
+
+func kindIndex(kind Kind) int {
+	for i, k := range manifestOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(manifestOrder)
+}
+
+// checkImmutable rejects the changes the API cannot make to a resource.
+func checkImmutable(kind Kind, live *liveResource, spec map[string]any) error {
+	var field string
+	switch kind {
+	case KindCheck:
+		field = "check_type"
+	case KindIntegration:
+		field = "module"
+	default:
+		return nil
+	}
+	if v, ok := spec[field]; ok && !sameValue(v, live.raw[field]) {
+		return fmt.Errorf("%s %q: cannot change %s from %v to %v, delete the resource first", kind, live.name, field, live.raw[field], v)
+	}
+	return nil
+}
+
+// diffSpec compares the fields set in desired with live, both in manifest
+// form.
+func diffSpec(kind Kind, desired, live map[string]any) []FieldDiff {
+	skip := make(map[string]bool)
+	for _, ck := range childKinds(kind) {
+		skip[manifestKinds[ck].key] = true
+	}
+	for k := range desired {
+		if kindSecrets(kind)[k] || contains(serverManagedFields, k) || contains(manifestKinds[kind].serverFields, k) {
+			skip[k] = true
+		}
+	}
+	var diffs []FieldDiff
+	var walk func(prefix string, desired, live map[string]any)
+	walk = func(prefix string, desired, live map[string]any) {
+		for _, k := range sortedKeys(desired) {
+			if prefix == "" && skip[k] {
+				continue
+			}
+			d, l := desired[k], live[k]
+			dm, ok := d.(map[string]any)
+			if lm, isMap := l.(map[string]any); ok && (isMap || l == nil) {
+				walk(prefix+k+".", dm, lm)
+				continue
+			}
+			if !sameValue(d, l) {
+				diffs = append(diffs, FieldDiff{Field: prefix + k, Old: l, New: d})
+			}
+		}
+	}
+	walk("", desired, live)
+	return diffs
+}
+
+// sameValue reports whether the manifest value desired matches the live
+// value. Maps match when the keys of desired do, a missing value matches a
+// zero one, and numbers match their string form.
+func sameValue(desired, live any) bool {
+	if isZero(desired) && isZero(live) {
+		return true
+	}
+	switch d := desired.(type) {
+	case map[string]any:
+		l, _ := live.(map[string]any)
+		for k, v := range d {
+			if !sameValue(v, l[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		l, _ := live.([]any)
+		if len(d) != len(l) {
+			return false
+		}
+		for i := range d {
+			if !sameValue(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	case float64:
+		switch l := live.(type) {
+		case float64:
+			return d == l
+		case string:
+			f, err := strconv.ParseFloat(l, 64)
+			return err == nil && f == d
+		}
+		return false
+	case string:
+		if l, ok := live.(float64); ok {
+			return sameValue(l, d)
+		}
+	}
+	return desired == live
+}
+
+func isZero(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// ApplyPlan makes the changes of plan, in order, and stops at the first
+// failure. Resources created along the way can be referred to by the
+// following changes.
+func ApplyPlan(ctx context.Context, api API, plan *Plan) error {
+	return applyPlan(ctx, api, plan, nil)
+}
+
+// applyPlan is ApplyPlan, calling applied, when not nil, after each change.
+func applyPlan(ctx context.Context, api API, plan *Plan, applied func(*Change) error) error {
+	for i := range plan.Changes {
+		c := &plan.Changes[i]
+		err := applyChange(ctx, api, plan.state, c)
+		if err == nil && applied != nil {
+			err = applied(c)
+		}
+		if err != nil {
+			if c.Parent != "" {
+				return fmt.Errorf("%s %s %q of %s %q: %w", c.Action, c.Kind, c.Name, manifestKinds[c.Kind].parent, c.Parent, err)
+			}
+			return fmt.Errorf("%s %s %q: %w", c.Action, c.Kind, c.Name, err)
+		}
+	}
+	return nil
+}
+
+func applyChange(ctx context.Context, api API, st *liveState, c *Change) error {
+	mk := manifestKinds[c.Kind]
+	var parent *liveResource
+	var parentPK PrimaryKey
+	if mk.parent != "" {
+		var err error
+		if parent, err = st.lookup(mk.parent, "", c.Parent); err != nil {
+			return err
+		}
+		if parent == nil {
+			return fmt.Errorf("%s %q not found", mk.parent, c.Parent)
+		}
+		parentPK = parent.pk
+	}
+	if c.Action == ChangeDelete {
+		if err := mk.delete(ctx, api, parentPK, c.PK); err != nil {
+			return err
+		}
+		st.remove(c.live)
+		return nil
+	}
+	var base map[string]any
+	if c.live != nil {
+		base = c.live.raw
+	}
+	raw, err := st.toRaw(c.Kind, c.Parent, c.Name, base, c.spec)
+	if err != nil {
+		return err
+	}
+	if c.Action == ChangeUpdate {
+		if mk.update != nil {
+			return mk.update(ctx, api, parentPK, c.PK, raw)
+		}
+		// The resource cannot be updated in place: recreate it.
+		if err := mk.delete(ctx, api, parentPK, c.PK); err != nil {
+			return err
+		}
+		st.remove(c.live)
+	}
+	created, err := mk.create(ctx, api, parentPK, raw)
+	if err != nil {
+		return err
+	}
+	if created[mk.nameField] == nil {
+		created[mk.nameField] = c.Name
+	}
+	c.PK = st.add(c.Kind, parent, created).pk
+	return nil
+}
@@ -0,0 +1,486 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// liveResource is a resource of the account, in API and manifest form.
+type liveResource struct {
+	kind Kind
+	// parent is the resource nested resources belong to.
+	parent *liveResource
+	name   string
+	pk     PrimaryKey
+	raw    map[string]any
+	spec   map[string]any
+}
+
+// key returns the key of r, made of its kind, the name of its parent and its
+// name.
+func (r *liveResource) key() resourceKey {
+	key := resourceKey{kind: r.kind, name: r.name}
+	if r.parent != nil {
+		key.parent = r.parent.name
+	}
+	return key
+}
+
+type resourceKey struct {
+	kind   Kind
+	parent string
+	name   string
+}
+
+// liveState holds the resources of an account, indexed by kind, parent and
+// name. Resources created while applying a plan are added as they are, so
+// that later changes can refer to them.
+type liveState struct {
+	resources []*liveResource
+	byKey     map[resourceKey][]*liveResource
+	pks       map[resourceKey]PrimaryKey
+	names     map[Kind]map[PrimaryKey]string
+	urls      map[string]*liveResource
+}
+
+// readLive lists the resources of the given kinds, those nested in them, and
+// those they refer to.
+func readLive(ctx context.Context, api API, kinds map[Kind]bool) (*liveState, error) {
+	st := &liveState{
+		byKey: make(map[resourceKey][]*liveResource),
+		pks:   make(map[resourceKey]PrimaryKey),
+		names: make(map[Kind]map[PrimaryKey]string),
+		urls:  make(map[string]*liveResource),
+	}
+	need := make(map[Kind]bool)
+	var require func(k Kind)
+	require = func(k Kind) {
+		if need[k] {
+			return
+		}
+		need[k] = true
+		mk := manifestKinds[k]
+		if mk.parent != "" {
+			require(mk.parent)
+		}
+		for _, r := range mk.refers {
+			require(r)
+		}
+		for _, ck := range childKinds(k) {
+			require(ck)
+		}
+	}
+	for k := range kinds {
+		require(k)
+	}
+	for _, k := range manifestOrder {
+		mk := manifestKinds[k]
+		if !need[k] {
+			continue
+		}
+		switch {
+		case mk.parent == "":
+			raws, err := mk.list(ctx, api, 0)
+			if err != nil {
+				return nil, fmt.Errorf("list %s: %w", k, err)
+			}
+			for _, raw := range raws {
+				st.add(k, nil, raw)
+			}
+		case mk.listAll != nil:
+			raws, err := mk.listAll(ctx, api)
+			if err != nil {
+				return nil, fmt.Errorf("list %s: %w", k, err)
+			}
+			for _, raw := range raws {
+				// Resources whose parent is gone are left out.
+				if parent := mk.parentOf(st, raw); parent != nil {
+					st.add(k, parent, raw)
+				}
+			}
+		default:
+			var parents []*liveResource
+			for _, r := range st.resources {
+				if r.kind == mk.parent {
+					parents = append(parents, r)
+				}
+			}
+			for _, parent := range parents {
+				raws, err := mk.list(ctx, api, parent.pk)
+				if err != nil {
+					return nil, fmt.Errorf("list %s of %s %q: %w", k, parent.kind, parent.name, err)
+				}
+				for _, raw := range raws {
+					st.add(k, parent, raw)
+				}
+			}
+		}
+	}
+	for _, r := range st.resources {
+		r.spec = st.toSpec(r)
+	}
+	for _, r := range st.resources {
+		if r.parent == nil || manifestKinds[r.kind].refOnly {
+			continue
+		}
+		mk := manifestKinds[r.kind]
+		items, _ := r.parent.spec[mk.key].([]any)
+		item := copyMap(r.spec)
+		item[mk.nameField] = r.name
+		r.parent.spec[mk.key] = append(items, item)
+	}
+	for _, r := range st.resources {
+		for _, ck := range childKinds(r.kind) {
+			mk := manifestKinds[ck]
+			items, _ := r.spec[mk.key].([]any)
+			sort.SliceStable(items, func(i, j int) bool {
+				return fmt.Sprint(items[i].(map[string]any)[mk.nameField]) < fmt.Sprint(items[j].(map[string]any)[mk.nameField])
+			})
+		}
+	}
+	return st, nil
+}
+
+func (st *liveState) add(kind Kind, parent *liveResource, raw map[string]any) *liveResource {
+	name, _ := raw[manifestKinds[kind].nameField].(string)
+	r := &liveResource{kind: kind, parent: parent, name: name, pk: rawPK(raw), raw: raw}
+	key := r.key()
+	st.resources = append(st.resources, r)
+	st.byKey[key] = append(st.byKey[key], r)
+	st.pks[key] = r.pk
+	if st.names[kind] == nil {
+		st.names[kind] = make(map[PrimaryKey]string)
+	}
+	st.names[kind][r.pk] = name
+	if url, ok := raw["url"].(string); ok && url != "" {
+		st.urls[url] = r
+	}
+	return r
+}
+
+// remove forgets r, once deleted.
+func (st *liveState) remove(r *liveResource) {
+	key := r.key()
+	var left []*liveResource
+	for _, other := range st.byKey[key] {
+		if other != r {
+			left = append(left, other)
+		}
+	}
+	st.byKey[key] = left
+	if len(left) == 0 {
+		delete(st.pks, key)
+	} else {
+		st.pks[key] = left[len(left)-1].pk
+	}
+}
+
+// lookup returns the live resource of kind named name, nil when there is
+// none, or an error when several share the name.
+func (st *liveState) lookup(kind Kind, parent, name string) (*liveResource, error) {
+	found := st.byKey[resourceKey{kind: kind, parent: parent, name: name}]
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return found[0], nil
+	}
+	pks := make([]string, len(found))
+	for i, r := range found {
+		pks[i] = fmt.Sprint(r.pk)
+	}
+	return nil, fmt.Errorf("%s name %q is shared by %s", kind, name, strings.Join(pks, ", "))
+}
+
+// pk returns the primary key of the resource of kind named name, live or
+// created since the state was read.
+func (st *liveState) pk(kind Kind, parent, name string) (PrimaryKey, error) {
+	pk, ok := st.pks[resourceKey{kind: kind, parent: parent, name: name}]
+	if !ok {
+		if parent != "" {
+			return 0, fmt.Errorf("%s %q not found in %s %q", kind, name, manifestKinds[kind].parent, parent)
+		}
+		return 0, fmt.Errorf("%s %q not found", kind, name)
+	}
+	return pk, nil
+}
+
+// toSpec returns the manifest form of r: its API form without its name,
+// secrets and server-managed fields, and with references made by name.
+func (st *liveState) toSpec(r *liveResource) map[string]any {
+	mk := manifestKinds[r.kind]
+	spec := stripServerFields(copyMap(r.raw))
+	delete(spec, mk.nameField)
+	for _, f := range mk.serverFields {
+		delete(spec, f)
+	}
+	for _, ck := range childKinds(r.kind) {
+		delete(spec, manifestKinds[ck].key)
+	}
+	for k := range spec {
+		if kindSecrets(r.kind)[k] {
+			delete(spec, k)
+		}
+	}
+	ref := func(kind Kind, v any) any {
+		if pk, ok := asPK(v); ok {
+			if name, ok := st.names[kind][pk]; ok {
+				return name
+			}
+		}
+		return v
+	}
+	switch r.kind {
+	case KindSLAReport:
+		if services, ok := spec["services_selected"].([]any); ok {
+			for i := range services {
+				services[i] = ref(KindCheck, services[i])
+			}
+		}
+	case KindScheduledReport:
+		if v, ok := spec["sla_report"]; ok {
+			if report, ok := st.urls[fmt.Sprint(v)]; ok && report.kind == KindSLAReport {
+				spec["sla_report"] = report.name
+			} else {
+				spec["sla_report"] = ref(KindSLAReport, v)
+			}
+		}
+	case KindServiceVariable:
+		if v, ok := spec["credential_id"]; ok {
+			spec["credential"] = ref(kindCredential, v)
+		}
+		delete(spec, "credential_id")
+	case KindStatusPageComponent, KindStatusPageMetric:
+		if v, ok := spec["service_id"]; ok && v != nil {
+			spec["service"] = ref(KindCheck, v)
+		}
+		if v, ok := spec["group_id"]; ok && v != nil {
+			spec["group"] = ref(KindStatusPageComponent, v)
+		}
+		delete(spec, "service_id")
+		delete(spec, "group_id")
+	case KindStatusPageIncident:
+		if affected, ok := spec["affected_components"].([]any); ok {
+			for i, a := range affected {
+				a, _ := a.(map[string]any)
+				component, _ := a["component"].(map[string]any)
+				affected[i] = map[string]any{
+					"component": ref(KindStatusPageComponent, component["id"]),
+					"status":    a["status"],
+				}
+			}
+		}
+		if updates, ok := spec["updates"].([]any); ok {
+			for _, u := range updates {
+				if u, ok := u.(map[string]any); ok {
+					delete(u, "id")
+				}
+			}
+		}
+	}
+	return dropEmpty(spec)
+}
+
+// toRaw returns the API form of the resource of kind named name described by
+// spec, based on base, the API form of the live resource when there is one.
+// References by name are resolved to primary keys.
+func (st *liveState) toRaw(kind Kind, parent, name string, base, spec map[string]any) (map[string]any, error) {
+	mk := manifestKinds[kind]
+	raw := stripServerFields(copyMap(base))
+	for k, v := range spec {
+		raw[k] = v
+	}
+	for _, ck := range childKinds(kind) {
+		delete(raw, manifestKinds[ck].key)
+	}
+	raw[mk.nameField] = name
+	resolve := func(field string, kind Kind, parent string, v any) (any, error) {
+		s, ok := v.(string)
+		if !ok {
+			return v, nil
+		}
+		if s == "" {
+			return nil, nil
+		}
+		pk, err := st.pk(kind, parent, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		return pk, nil
+	}
+	var err error
+	switch kind {
+	case KindScheduledReport:
+		// The API refers to the SLA report by URL.
+		if name, ok := raw["sla_report"].(string); ok {
+			if report, _ := st.lookup(KindSLAReport, "", name); report != nil {
+				if url, ok := report.raw["url"].(string); ok && url != "" {
+					raw["sla_report"] = url
+				}
+			}
+		}
+	case KindServiceVariable:
+		if v, ok := raw["credential"]; ok {
+			delete(raw, "credential")
+			if raw["credential_id"], err = resolve("credential", kindCredential, "", v); err != nil {
+				return nil, err
+			}
+		}
+	case KindStatusPageComponent, KindStatusPageMetric:
+		if v, ok := raw["service"]; ok {
+			delete(raw, "service")
+			if raw["service_id"], err = resolve("service", KindCheck, "", v); err != nil {
+				return nil, err
+			}
+		}
+		if v, ok := raw["group"]; ok {
+			delete(raw, "group")
+			if raw["group_id"], err = resolve("group", KindStatusPageComponent, parent, v); err != nil {
+				return nil, err
+			}
+		}
+	case KindStatusPageIncident:
+		if affected, ok := raw["affected_components"].([]any); ok {
+			entities := make([]any, len(affected))
+			for i, a := range affected {
+				a, _ := a.(map[string]any)
+				pk, err := resolve("affected_components", KindStatusPageComponent, parent, a["component"])
+				if err != nil {
+					return nil, err
+				}
+				entities[i] = map[string]any{"component": map[string]any{"id": pk}, "status": a["status"]}
+			}
+			raw["affected_components"] = entities
+		}
+	}
+	return raw, nil
+}
+
+// specRefs returns the resources spec refers to by name.
+func specRefs(kind Kind, parent string, spec map[string]any) []resourceKey {
+	var refs []resourceKey
+	add := func(kind Kind, parent string, v any) {
+		if s, ok := v.(string); ok && s != "" {
+			refs = append(refs, resourceKey{kind: kind, parent: parent, name: s})
+		}
+	}
+	switch kind {
+	case KindServiceVariable:
+		add(kindCredential, "", spec["credential"])
+	case KindStatusPageComponent, KindStatusPageMetric:
+		add(KindCheck, "", spec["service"])
+		add(KindStatusPageComponent, parent, spec["group"])
+	case KindStatusPageIncident:
+		affected, _ := spec["affected_components"].([]any)
+		for _, a := range affected {
+			a, _ := a.(map[string]any)
+			add(KindStatusPageComponent, parent, a["component"])
+		}
+	}
+	return refs
+}
+
+func rawPK(raw map[string]any) PrimaryKey {
+	if pk, ok := asPK(raw["pk"]); ok {
+		return pk
+	}
+	pk, _ := asPK(raw["id"])
+	return pk
+}
+
+func asPK(v any) (PrimaryKey, bool) {
+	switch v := v.(type) {
+	case float64:
+		return PrimaryKey(v), true
+	case int:
+		return PrimaryKey(v), true
+	case int64:
+		return PrimaryKey(v), true
+	case PrimaryKey:
+		return v, true
+	}
+	return 0, false
+}
+
+// stripServerFields removes the serverManagedFields and state_ fields from m
+// and the maps it holds, and returns m.
+func stripServerFields(m map[string]any) map[string]any {
+	for k, v := range m {
+		if strings.HasPrefix(k, "state_") || contains(serverManagedFields, k) {
+			delete(m, k)
+			continue
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			stripServerFields(v)
+		case []any:
+			for _, item := range v {
+				if item, ok := item.(map[string]any); ok {
+					stripServerFields(item)
+				}
+			}
+		}
+	}
+	return m
+}
+
+// dropEmpty removes the null, empty string, list and map fields of m and the
+// maps it holds, and returns m.
+func dropEmpty(m map[string]any) map[string]any {
+	for k, v := range m {
+		if sub, ok := v.(map[string]any); ok {
+			dropEmpty(sub)
+		}
+		if isZero(v) {
+			switch v.(type) {
+			case bool, float64:
+				continue
+			}
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+// copyMap returns a deep copy of m.
+func copyMap(m map[string]any) map[string]any {
+	c := make(map[string]any, len(m))
+	for k, v := range m {
+		c[k] = copyValue(v)
+	}
+	return c
+}
+
+func copyValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return copyMap(v)
+	case []any:
+		c := make([]any, len(v))
+		for i := range v {
+			c[i] = copyValue(v[i])
+		}
+		return c
+	}
+	return v
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,203 @@
+package upapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapitest"
+)
+
+// newTestAccount starts a fake API holding items, a JSON array of items for
+// each collection, and returns it with a client.
+func newTestAccount(t *testing.T, items map[string]string) (*upapitest.Server, upapi.API) {
+	fake := upapitest.NewServer()
+	t.Cleanup(fake.Close)
+	for collection, body := range items {
+		var list []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(body), &list))
+		for _, item := range list {
+			fake.Add(collection, item)
+		}
+	}
+	api, err := upapi.New(upapi.WithBaseURL(fake.URL), upapi.WithToken("test"))
+	require.NoError(t, err)
+	return fake, api
+}
+
+// findItem returns the first item of collection whose field is value, or nil.
+func findItem(t *testing.T, fake *upapitest.Server, collection, field string, value any) map[string]any {
+	var items []map[string]any
+	require.True(t, fake.List(collection, &items))
+	for _, item := range items {
+		if item[field] == value {
+			return item
+		}
+	}
+	return nil
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "tags.yaml"), `
+- kind: Tag
+  name: prod
+  spec: {color_hex: "#ff0000"}
+- kind: Tag
+  name: staging
+`)
+	writeFile(t, filepath.Join(dir, "checks", "web.yml"), `
+kind: Check
+name: web
+spec:
+  check_type: HTTP
+  msp_interval: 5
+---
+kind: Check
+name: api
+spec: {check_type: HTTP}
+`)
+	writeFile(t, filepath.Join(dir, "contact.json"), `{"kind": "Contact", "name": "Ops", "spec": {"email_list": ["ops@example.com"]}}`)
+	writeFile(t, filepath.Join(dir, "README.md"), "not a manifest")
+
+	manifests, err := upapi.LoadManifests(dir)
+	require.NoError(t, err)
+	require.Equal(t, []upapi.Manifest{
+		{Kind: upapi.KindCheck, Name: "web", Spec: map[string]any{"check_type": "HTTP", "msp_interval": float64(5)}},
+		{Kind: upapi.KindCheck, Name: "api", Spec: map[string]any{"check_type": "HTTP"}},
+		{Kind: upapi.KindContact, Name: "Ops", Spec: map[string]any{"email_list": []any{"ops@example.com"}}},
+		{Kind: upapi.KindTag, Name: "prod", Spec: map[string]any{"color_hex": "#ff0000"}},
+		{Kind: upapi.KindTag, Name: "staging", Spec: map[string]any{}},
+	}, manifests)
+
+	writeFile(t, filepath.Join(dir, "dup.yaml"), "kind: Tag\nname: prod\n")
+	_, err = upapi.LoadManifests(dir)
+	require.ErrorContains(t, err, `Tag "prod" is already defined`)
+
+	writeFile(t, filepath.Join(dir, "dup.yaml"), "kind: Widget\nname: prod\n")
+	_, err = upapi.LoadManifests(dir)
+	require.ErrorContains(t, err, `unknown manifest kind "Widget"`)
+}
+
+func TestPlanAndApplyManifests(t *testing.T) {
+	fake, api := newTestAccount(t, map[string]string{
+		"check-tags": `[{"pk": 1, "url": "https://uptime.com/api/v1/check-tags/1/", "tag": "prod", "color_hex": "#000000"}, {"pk": 2, "tag": "stale", "color_hex": "#00ff00"}]`,
+		"checks": `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_interval": 5, "msp_address": "https://example.com",
+			"state_is_up": true, "created_at": "2024-01-01T00:00:00Z", "tags": ["prod"]}]`,
+		"statuspages": `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components": `[{"pk": 71, "name": "Web", "service_id": 10, "group_id": null},
+			{"pk": 72, "name": "Old", "service_id": null, "group_id": null}]`,
+	})
+
+	manifests := []upapi.Manifest{
+		{Kind: upapi.KindTag, Name: "prod", Spec: map[string]any{"color_hex": "#ff0000"}},
+		{Kind: upapi.KindTag, Name: "new"},
+		{Kind: upapi.KindCheck, Name: "web", Spec: map[string]any{"check_type": "HTTP", "msp_interval": 5, "tags": []any{"prod"}}},
+		{Kind: upapi.KindCheck, Name: "api", Spec: map[string]any{"check_type": "HTTP", "msp_address": "https://api.example.com", "msp_interval": 1}},
+		{Kind: upapi.KindStatusPage, Name: "Public", Spec: map[string]any{
+			"slug": "public",
+			"components": []any{
+				map[string]any{"name": "Web", "service": "web"},
+				map[string]any{"name": "API", "service": "api"},
+			},
+		}},
+	}
+
+	plan, err := upapi.PlanManifests(context.Background(), api, manifests, upapi.PlanOptions{})
+	require.NoError(t, err)
+	summary := func(p *upapi.Plan) []string {
+		var s []string
+		for _, c := range p.Changes {
+			s = append(s, string(c.Action)+" "+string(c.Kind)+" "+c.Name)
+		}
+		return s
+	}
+	require.Equal(t, []string{
+		"update Tag prod",
+		"create Tag new",
+		"create Check api",
+		"create StatusPageComponent API",
+	}, summary(plan))
+	require.Equal(t, []upapi.FieldDiff{{Field: "color_hex", Old: "#000000", New: "#ff0000"}}, plan.Changes[0].Diff)
+	require.Equal(t, []upapi.FieldDiff{
+		{Field: "check_type", New: "HTTP"},
+		{Field: "msp_address", New: "https://api.example.com"},
+		{Field: "msp_interval", New: float64(1)},
+	}, plan.Changes[2].Diff)
+	require.Equal(t, 3, plan.Unchanged)
+
+	plan, err = upapi.PlanManifests(context.Background(), api, manifests, upapi.PlanOptions{Prune: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"update Tag prod",
+		"create Tag new",
+		"create Check api",
+		"create StatusPageComponent API",
+		"delete StatusPageComponent Old",
+		"delete Tag stale",
+	}, summary(plan))
+	require.Equal(t, []upapi.FieldDiff{{Field: "color_hex", Old: "#00ff00"}}, plan.Changes[5].Diff)
+
+	require.NoError(t, upapi.ApplyPlan(context.Background(), api, plan))
+	require.Equal(t, "#ff0000", findItem(t, fake, "check-tags", "tag", "prod")["color_hex"])
+	require.Nil(t, findItem(t, fake, "check-tags", "tag", "stale"))
+	check := findItem(t, fake, "checks", "name", "api")
+	require.Equal(t, "HTTP", check["check_type"])
+	component := findItem(t, fake, "statuspages/70/components", "name", "API")
+	require.Equal(t, check["pk"], component["service_id"])
+	require.Nil(t, findItem(t, fake, "statuspages/70/components", "name", "Old"))
+
+	plan, err = upapi.PlanManifests(context.Background(), api, manifests, upapi.PlanOptions{Prune: true})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+}
+
+func TestPlanManifestsErrors(t *testing.T) {
+	_, api := newTestAccount(t, map[string]string{
+		"checks":      `[{"pk": 10, "name": "web", "check_type": "HTTP"}, {"pk": 11, "name": "dup", "check_type": "DNS"}, {"pk": 12, "name": "dup", "check_type": "DNS"}]`,
+		"statuspages": `[]`,
+	})
+	for _, tc := range []struct {
+		name      string
+		manifests []upapi.Manifest
+		err       string
+	}{
+		{
+			name:      "check type change",
+			manifests: []upapi.Manifest{{Kind: upapi.KindCheck, Name: "web", Spec: map[string]any{"check_type": "DNS"}}},
+			err:       `cannot change check_type from HTTP to DNS`,
+		},
+		{
+			name:      "ambiguous name",
+			manifests: []upapi.Manifest{{Kind: upapi.KindCheck, Name: "dup"}},
+			err:       `Check name "dup" is shared by 11, 12`,
+		},
+		{
+			name: "unknown reference",
+			manifests: []upapi.Manifest{{Kind: upapi.KindStatusPage, Name: "Public", Spec: map[string]any{
+				"metrics": []any{map[string]any{"name": "Latency", "service": "gone"}},
+			}}},
+			err: `StatusPageMetric "Latency" refers to Check "gone"`,
+		},
+		{
+			name:      "duplicate manifest",
+			manifests: []upapi.Manifest{{Kind: upapi.KindCheck, Name: "web"}, {Kind: upapi.KindCheck, Name: "web"}},
+			err:       `Check "web" has several manifests`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := upapi.PlanManifests(context.Background(), api, tc.manifests, upapi.PlanOptions{})
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
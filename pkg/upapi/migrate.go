@@ -0,0 +1,252 @@
+package upapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MigrateKinds lists the kinds PlanMigration copies by default.
+var MigrateKinds = []Kind{KindTag, KindContact, KindIntegration, KindCheck, KindDashboard, KindSLAReport, KindStatusPage}
+
+// migrateSkipped lists the nested kinds a migration leaves out: service
+// variables need credentials, which cannot be copied, and the rest concerns
+// the people and events of the source account.
+var migrateSkipped = []Kind{KindServiceVariable, KindStatusPageIncident, KindStatusPageSubscriber, KindStatusPageUser}
+
+// MigrateOptions specifies the optional parameters to PlanMigration.
+type MigrateOptions struct {
+	// Kinds lists the kinds of resources to copy, MigrateKinds by default.
+	Kinds []Kind
+	// SecretPlaceholder is set in the secret fields of the integrations
+	// created, since the API does not return secrets, prefixed with
+	// https://example.com/ for URLs. CHANGE_ME by default.
+	SecretPlaceholder string
+	// UpdateExisting updates the resources of the target named like a
+	// resource of the source, which are otherwise left as they are.
+	UpdateExisting bool
+}
+
+// MigratedResource is a resource copied by a migration.
+type MigratedResource struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+	// Parent is the name of the resource a nested resource belongs to.
+	Parent   string     `json:"parent,omitempty"`
+	SourcePK PrimaryKey `json:"source_pk"`
+	TargetPK PrimaryKey `json:"target_pk"`
+}
+
+// MigrationState records the resources a migration copied, along with their
+// primary keys in the source and target accounts. It is saved after every
+// change, so that a migration interrupted by a failure can be resumed: the
+// resources it records are created if missing but never updated again, which
+// keeps the changes made to them since, such as secrets filled in.
+type MigrationState struct {
+	Source    int64              `json:"source_subaccount"`
+	Target    int64              `json:"target_subaccount"`
+	Resources []MigratedResource `json:"resources"`
+
+	path    string
+	sources map[resourceKey]PrimaryKey
+}
+
+// LoadMigrationState reads the state of the migration from the subaccount
+// source to target saved at path, or returns an empty one when there is no
+// file at path yet.
+func LoadMigrationState(path string, source, target int64) (*MigrationState, error) {
+	s := &MigrationState{Source: source, Target: target, Resources: []MigratedResource{}, path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if s.Source != source || s.Target != target {
+		return nil, fmt.Errorf("%s: state of the migration from subaccount %d to %d", path, s.Source, s.Target)
+	}
+	return s, nil
+}
+
+// Save writes s to the file it was loaded from.
+func (s *MigrationState) Save() error {
+	sort.SliceStable(s.Resources, func(i, j int) bool {
+		a, b := s.Resources[i], s.Resources[j]
+		if a.Kind != b.Kind {
+			return kindIndex(a.Kind) < kindIndex(b.Kind)
+		}
+		if a.Parent != b.Parent {
+			return a.Parent < b.Parent
+		}
+		return a.Name < b.Name
+	})
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(append(b, '\n'))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+func (s *MigrationState) migrated(key resourceKey) bool {
+	for _, r := range s.Resources {
+		if r.Kind == key.kind && r.Parent == key.parent && r.Name == key.name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MigrationState) record(key resourceKey, pk PrimaryKey) {
+	for i, r := range s.Resources {
+		if r.Kind == key.kind && r.Parent == key.parent && r.Name == key.name {
+			s.Resources[i].TargetPK = pk
+			return
+		}
+	}
+	s.Resources = append(s.Resources, MigratedResource{
+		Kind:     key.kind,
+		Name:     key.name,
+		Parent:   key.parent,
+		SourcePK: s.sources[key],
+		TargetPK: pk,
+	})
+}
+
+// PlanMigration plans copying the resources of source into target. Resources
+// are matched by kind and name, and references between them are made by name,
+// so that they point at the copies. Those the target has already are only
+// updated with opts.UpdateExisting. Service variables, as well as the
+// incidents, subscribers and users of status pages, are left out. The
+// integrations created get placeholder secrets. Resources recorded in state
+// are not updated; ApplyMigration records the others as it copies them. The
+// resources of source sharing their kind and name with another cannot be
+// matched, and are listed in the Skipped field of the plan instead.
+func PlanMigration(ctx context.Context, source, target API, state *MigrationState, opts MigrateOptions) (*Plan, error) {
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = MigrateKinds
+	}
+	want := make(map[Kind]bool, len(kinds))
+	for _, k := range kinds {
+		if mk, ok := manifestKinds[k]; !ok || mk.parent != "" || mk.refOnly {
+			return nil, fmt.Errorf("unknown manifest kind %q", k)
+		}
+		want[k] = true
+	}
+	src, err := readLive(ctx, source, want)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	manifests, err := src.manifests(want)
+	var dups *DuplicateNamesError
+	if err != nil && !errors.As(err, &dups) {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	for i, m := range manifests {
+		spec := copyMap(m.Spec)
+		for _, k := range migrateSkipped {
+			if manifestKinds[k].parent == m.Kind {
+				delete(spec, manifestKinds[k].key)
+			}
+		}
+		manifests[i].Spec = spec
+	}
+	state.sources = make(map[resourceKey]PrimaryKey, len(src.resources))
+	for _, r := range src.resources {
+		if migrates(r, want) && len(src.byKey[r.key()]) == 1 {
+			state.sources[r.key()] = r.pk
+		}
+	}
+
+	plan, err := PlanManifests(ctx, target, manifests, PlanOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	placeholder := opts.SecretPlaceholder
+	if placeholder == "" {
+		placeholder = "CHANGE_ME"
+	}
+	changes := plan.Changes[:0]
+	for _, c := range plan.Changes {
+		if c.Action == ChangeUpdate && (!opts.UpdateExisting || state.migrated(resourceKey{kind: c.Kind, parent: c.Parent, name: c.Name})) {
+			continue
+		}
+		if c.Action == ChangeCreate && c.Kind == KindIntegration {
+			if m, err := integrationModuleOf(c.spec["module"]); err == nil {
+				for _, f := range m.secrets {
+					if _, ok := c.spec[f]; ok {
+						continue
+					}
+					if strings.HasSuffix(f, "url") {
+						c.spec[f] = "https://example.com/" + placeholder
+					} else {
+						c.spec[f] = placeholder
+					}
+				}
+			}
+		}
+		changes = append(changes, c)
+	}
+	plan.Changes = changes
+	if dups != nil {
+		plan.Skipped = dups.Duplicates
+	}
+	return plan, nil
+}
+
+// migrates reports whether r is copied by a migration of the given kinds.
+func migrates(r *liveResource, want map[Kind]bool) bool {
+	for _, k := range migrateSkipped {
+		if r.kind == k {
+			return false
+		}
+	}
+	for r.parent != nil {
+		r = r.parent
+	}
+	return want[r.kind]
+}
+
+// ApplyMigration makes the changes of a plan returned by PlanMigration, like
+// ApplyPlan, and saves state after each of them. Once every change is made,
+// the resources which needed none are recorded too, so that state maps the
+// primary key of every resource copied.
+func ApplyMigration(ctx context.Context, target API, plan *Plan, state *MigrationState) error {
+	err := applyPlan(ctx, target, plan, func(c *Change) error {
+		if c.Action == ChangeDelete {
+			return nil
+		}
+		state.record(resourceKey{kind: c.Kind, parent: c.Parent, name: c.Name}, c.PK)
+		return state.Save()
+	})
+	if err != nil {
+		return err
+	}
+	for key := range state.sources {
+		if pk, ok := plan.state.pks[key]; ok && !state.migrated(key) {
+			state.record(key, pk)
+		}
+	}
+	return state.Save()
+}
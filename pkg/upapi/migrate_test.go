@@ -0,0 +1,183 @@
+package upapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapitest"
+)
+
+func TestMigration(t *testing.T) {
+	_, source := newTestAccount(t, map[string]string{
+		"check-tags":                 `[{"pk": 1, "tag": "prod", "color_hex": "#ff0000"}]`,
+		"contacts":                   `[{"pk": 2, "name": "Ops", "email_list": ["ops@example.com"]}]`,
+		"integrations":               `[{"pk": 3, "name": "Ops Slack", "module": "slack", "channel": "#ops", "contact_groups": ["Ops"]}]`,
+		"checks":                     `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_address": "https://example.com", "contact_groups": ["Ops"], "tags": ["prod"]}]`,
+		"servicevariables":           `[{"id": 40, "credential_id": 5, "variable_name": "USER", "service": "web"}]`,
+		"sla-reports":                `[{"pk": 50, "name": "Monthly", "services_selected": [10]}]`,
+		"statuspages":                `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components":  `[{"pk": 71, "name": "Web", "service_id": 10}]`,
+		"statuspages/70/subscribers": `[{"id": 75, "target": "a@example.com", "type": "EMAIL"}]`,
+	})
+	fake, target := newTestAccount(t, map[string]string{
+		"contacts": `[{"pk": 900, "name": "Ops", "email_list": ["oncall@example.com"]}]`,
+	})
+	path := filepath.Join(t.TempDir(), "migration.json")
+
+	state, err := upapi.LoadMigrationState(path, 1, 2)
+	require.NoError(t, err)
+	plan, err := upapi.PlanMigration(context.Background(), source, target, state, upapi.MigrateOptions{})
+	require.NoError(t, err)
+	created := []string{
+		"create Tag prod",
+		"create Integration Ops Slack",
+		"create Check web",
+		"create SLAReport Monthly",
+		"create StatusPage Public",
+		"create StatusPageComponent Web",
+	}
+	require.Equal(t, created, migrationChanges(plan))
+
+	// The contact the target has already is only overwritten on demand.
+	plan, err = upapi.PlanMigration(context.Background(), source, target, state, upapi.MigrateOptions{UpdateExisting: true})
+	require.NoError(t, err)
+	require.Equal(t, append(created[:1:1], append([]string{"update Contact Ops"}, created[1:]...)...), migrationChanges(plan))
+	require.NoError(t, upapi.ApplyMigration(context.Background(), target, plan, state))
+	require.Equal(t, []any{"ops@example.com"}, findItem(t, fake, "contacts", "name", "Ops")["email_list"])
+
+	integration := findItem(t, fake, "integrations", "name", "Ops Slack")
+	require.Equal(t, "https://example.com/CHANGE_ME", integration["webhook_url"])
+	check := findItem(t, fake, "checks", "name", "web")
+	require.Equal(t, []any{"Ops"}, check["contact_groups"])
+	require.Equal(t, []any{"web"}, findItem(t, fake, "sla-reports", "name", "Monthly")["services_selected"])
+	page := findItem(t, fake, "statuspages", "name", "Public")
+	component := findItem(t, fake, fmt.Sprintf("statuspages/%v/components", page["pk"]), "name", "Web")
+	require.Equal(t, check["pk"], component["service_id"])
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var saved upapi.MigrationState
+	require.NoError(t, json.Unmarshal(b, &saved))
+	require.Len(t, saved.Resources, 7)
+	require.Equal(t, upapi.MigratedResource{Kind: upapi.KindCheck, Name: "web", SourcePK: 10, TargetPK: upapi.PrimaryKey(check["pk"].(float64))}, saved.Resources[3])
+
+	// Once migrated, resources changed in the target are left alone.
+	integration["channel"] = "#alerts"
+	fake.Add("integrations", integration)
+	state, err = upapi.LoadMigrationState(path, 1, 2)
+	require.NoError(t, err)
+	plan, err = upapi.PlanMigration(context.Background(), source, target, state, upapi.MigrateOptions{})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	_, err = upapi.LoadMigrationState(path, 1, 3)
+	require.ErrorContains(t, err, "state of the migration from subaccount 1 to 2")
+}
+
+func TestMigrationDuplicateNames(t *testing.T) {
+	_, source := newTestAccount(t, map[string]string{
+		"checks": `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_address": "https://example.com"},
+			{"pk": 11, "name": "web", "check_type": "ICMP", "msp_address": "example.com"},
+			{"pk": 12, "name": "api", "check_type": "HTTP", "msp_address": "https://api.example.com"}]`,
+	})
+	_, target := newTestAccount(t, nil)
+	state, err := upapi.LoadMigrationState(filepath.Join(t.TempDir(), "migration.json"), 1, 2)
+	require.NoError(t, err)
+
+	plan, err := upapi.PlanMigration(context.Background(), source, target, state, upapi.MigrateOptions{Kinds: []upapi.Kind{upapi.KindCheck}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"create Check api"}, migrationChanges(plan))
+	require.Equal(t, []upapi.DuplicateName{{Kind: upapi.KindCheck, Name: "web", PKs: []upapi.PrimaryKey{10, 11}}}, plan.Skipped)
+}
+
+func TestMigrationResume(t *testing.T) {
+	ctx := context.Background()
+	sourceFake, source := newTestAccount(t, map[string]string{
+		"check-tags":                `[{"pk": 1, "tag": "prod"}]`,
+		"contacts":                  `[{"pk": 2, "name": "Ops", "email_list": ["ops@example.com"]}]`,
+		"integrations":              `[{"pk": 3, "name": "Ops Slack", "module": "slack", "channel": "#ops", "contact_groups": ["Ops"]}]`,
+		"checks":                    `[{"pk": 10, "name": "web", "check_type": "HTTP", "msp_address": "https://example.com", "contact_groups": ["Ops"], "tags": ["prod"]}]`,
+		"sla-reports":               `[{"pk": 50, "name": "Monthly", "services_selected": [10]}]`,
+		"statuspages":               `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components": `[{"pk": 71, "name": "Web", "service_id": 10}]`,
+	})
+	fake, target := newTestAccount(t, nil)
+	// The target rejects the check, so the migration stops halfway.
+	fake.Require("checks", "msp_notes")
+	path := filepath.Join(t.TempDir(), "migration.json")
+
+	state, err := upapi.LoadMigrationState(path, 1, 2)
+	require.NoError(t, err)
+	plan, err := upapi.PlanMigration(ctx, source, target, state, upapi.MigrateOptions{})
+	require.NoError(t, err)
+	require.True(t, upapi.IsValidation(upapi.ApplyMigration(ctx, target, plan, state)))
+
+	saved := loadMigrationState(t, path)
+	require.Equal(t, []upapi.MigratedResource{
+		{Kind: upapi.KindTag, Name: "prod", SourcePK: 1, TargetPK: targetPK(t, fake, "check-tags", "tag", "prod")},
+		{Kind: upapi.KindContact, Name: "Ops", SourcePK: 2, TargetPK: targetPK(t, fake, "contacts", "name", "Ops")},
+		{Kind: upapi.KindIntegration, Name: "Ops Slack", SourcePK: 3, TargetPK: targetPK(t, fake, "integrations", "name", "Ops Slack")},
+	}, saved.Resources)
+
+	// Once the check is fixed, running the migration again copies the rest.
+	check := findItem(t, sourceFake, "checks", "name", "web")
+	check["msp_notes"] = "Owned by Ops"
+	sourceFake.Add("checks", check)
+	state, err = upapi.LoadMigrationState(path, 1, 2)
+	require.NoError(t, err)
+	plan, err = upapi.PlanMigration(ctx, source, target, state, upapi.MigrateOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"create Check web",
+		"create SLAReport Monthly",
+		"create StatusPage Public",
+		"create StatusPageComponent Web",
+	}, migrationChanges(plan))
+	require.NoError(t, upapi.ApplyMigration(ctx, target, plan, state))
+
+	for _, collection := range []string{"check-tags", "contacts", "integrations", "checks", "sla-reports", "statuspages"} {
+		require.Equal(t, 1, fake.Len(collection), collection)
+	}
+	page := findItem(t, fake, "statuspages", "name", "Public")
+	components := fmt.Sprintf("statuspages/%v/components", page["pk"])
+	require.Equal(t, 1, fake.Len(components))
+	saved = loadMigrationState(t, path)
+	require.Equal(t, []upapi.MigratedResource{
+		{Kind: upapi.KindTag, Name: "prod", SourcePK: 1, TargetPK: targetPK(t, fake, "check-tags", "tag", "prod")},
+		{Kind: upapi.KindContact, Name: "Ops", SourcePK: 2, TargetPK: targetPK(t, fake, "contacts", "name", "Ops")},
+		{Kind: upapi.KindIntegration, Name: "Ops Slack", SourcePK: 3, TargetPK: targetPK(t, fake, "integrations", "name", "Ops Slack")},
+		{Kind: upapi.KindCheck, Name: "web", SourcePK: 10, TargetPK: targetPK(t, fake, "checks", "name", "web")},
+		{Kind: upapi.KindSLAReport, Name: "Monthly", SourcePK: 50, TargetPK: targetPK(t, fake, "sla-reports", "name", "Monthly")},
+		{Kind: upapi.KindStatusPage, Name: "Public", SourcePK: 70, TargetPK: targetPK(t, fake, "statuspages", "name", "Public")},
+		{Kind: upapi.KindStatusPageComponent, Name: "Web", Parent: "Public", SourcePK: 71, TargetPK: targetPK(t, fake, components, "name", "Web")},
+	}, saved.Resources)
+}
+
+func loadMigrationState(t *testing.T, path string) upapi.MigrationState {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var state upapi.MigrationState
+	require.NoError(t, json.Unmarshal(b, &state))
+	return state
+}
+
+func targetPK(t *testing.T, fake *upapitest.Server, collection, field, value string) upapi.PrimaryKey {
+	item := findItem(t, fake, collection, field, value)
+	require.NotNil(t, item, "%s %s", collection, value)
+	return upapi.PrimaryKey(item["pk"].(float64))
+}
+
+func migrationChanges(plan *upapi.Plan) []string {
+	var changes []string
+	for _, c := range plan.Changes {
+		changes = append(changes, string(c.Action)+" "+string(c.Kind)+" "+c.Name)
+	}
+	return changes
+}
@@ -11,7 +11,6 @@ import (
 	"net/http/httptrace"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -122,9 +121,11 @@ type withRateLimitCBD struct {
 }
 
 func (s *withRateLimitCBD) Do(rq *http.Request) (*http.Response, error) {
+	start := time.Now()
 	if err := s.limiter.Wait(rq.Context()); err != nil {
 		return nil, err
 	}
+	reportRateLimitWait(rq.Context(), time.Since(start))
 	return s.CBD.Do(rq)
 }
 
@@ -141,37 +142,39 @@ type withTraceCBD struct {
 
 func (t *withTraceCBD) Do(rq *http.Request) (*http.Response, error) {
 	rq = rq.WithContext(httptrace.WithClientTrace(rq.Context(), t.trace()))
-	buf := bytes.NewBuffer(nil)
-	if rq.Body != nil {
-		rq.Body = io.NopCloser(io.TeeReader(rq.Body, buf))
+	body, err := requestBody(rq)
+	if err != nil {
+		return nil, err
 	}
 	t.log.Println(rq.Method, rq.URL.String())
 	rs, err := t.CBD.Do(rq)
 	if err != nil {
 		return nil, err
 	}
-	if buf.Len() > 0 {
+	if len(body) > 0 {
 		t.log.Println("WroteRequestBody")
-		s := bufio.NewScanner(buf)
-		for s.Scan() {
-			t.log.Println(" +", s.Text())
-		}
-		buf.Reset()
+		t.printLines(redactBody(redactedKeys(rq.Context()), body))
 	}
 	t.log.Println("GotResponseHeader", rs.StatusCode)
-	_ = rs.Header.Write(buf)
-	s := bufio.NewScanner(buf)
-	for s.Scan() {
-		t.log.Println(" +", s.Text())
-	}
+	buf := bytes.NewBuffer(nil)
+	_ = redactHeader(rs.Header).Write(buf)
+	t.printLines(buf.Bytes())
 	t.log.Println("GotResponseBody")
-	buf.Reset()
-	s = bufio.NewScanner(io.TeeReader(rs.Body, buf))
+	body, err = io.ReadAll(rs.Body)
+	_ = rs.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	t.printLines(redactBody(redactedKeys(rq.Context()), body))
+	rs.Body = io.NopCloser(bytes.NewReader(body))
+	return rs, nil
+}
+
+func (t *withTraceCBD) printLines(b []byte) {
+	s := bufio.NewScanner(bytes.NewReader(b))
 	for s.Scan() {
 		t.log.Println(" +", s.Text())
 	}
-	rs.Body = io.NopCloser(buf)
-	return rs, nil
 }
 
 func (t *withTraceCBD) trace() *httptrace.ClientTrace {
@@ -193,14 +196,7 @@ func (t *withTraceCBD) trace() *httptrace.ClientTrace {
 			}
 		},
 		WroteHeaderField: func(key string, value []string) {
-			if key == "authorization" {
-				for i := range value {
-					if strings.HasPrefix(value[i], "Token ") {
-						value[i] = "Token " + strings.Repeat("*", len(value[i])-6)
-					}
-				}
-			}
-			t.log.Println("WroteHeaderField", key, value)
+			t.log.Println("WroteHeaderField", key, redactHeaderValues(key, value))
 		},
 		WroteHeaders: func() {
 			t.log.Println("WroteHeaders")
@@ -211,76 +207,6 @@ func (t *withTraceCBD) trace() *httptrace.ClientTrace {
 	}
 }
 
-func WithRetry(limit int, maxDelay time.Duration, w io.Writer) Option {
-	if w == nil {
-		w = io.Discard
-	}
-	l := log.New(w, "••• ", 0)
-	return func(cbd CBD) (CBD, error) {
-		return &autoRetryCBD{cbd, l, limit, maxDelay}, nil
-	}
-}
-
-type autoRetryCBD struct {
-	CBD
-	log           *log.Logger
-	countdown     int
-	retryAfterCap time.Duration
-}
-
-func (r *autoRetryCBD) Do(rq *http.Request) (*http.Response, error) {
-	var bodyBytes []byte
-	if rq.Body != nil {
-		var err error
-		bodyBytes, err = io.ReadAll(rq.Body)
-		if err != nil {
-			return nil, err
-		}
-		rq.Body.Close()
-		rq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-	}
-
-	return r.doWithRetry(rq, bodyBytes)
-}
-
-func (r *autoRetryCBD) doWithRetry(rq *http.Request, bodyBytes []byte) (*http.Response, error) {
-	rs, err := r.CBD.Do(rq)
-	if err != nil {
-		return nil, err
-	}
-	if rs.StatusCode != http.StatusTooManyRequests {
-		return rs, nil
-	}
-	if r.countdown == 0 {
-		return rs, nil
-	}
-
-	retryAfterSeconds, err := strconv.Atoi(rs.Header.Get("Retry-After"))
-	if err != nil {
-		return rs, nil
-	}
-	retryAfter := time.Duration(retryAfterSeconds) * time.Second
-
-	r.log.Printf("server instructed us to retry in %s", retryAfter)
-	if r.retryAfterCap > 0 && retryAfter > r.retryAfterCap {
-		r.log.Printf("...but delay is capped to %s", r.retryAfterCap)
-		retryAfter = r.retryAfterCap
-	}
-
-	wait, cancel := context.WithTimeout(rq.Context(), retryAfter)
-	defer cancel()
-	<-wait.Done()
-
-	r.log.Printf("proceeding, %d attempts left", r.countdown)
-
-	if bodyBytes != nil {
-		rq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-	}
-
-	r.countdown--
-	return r.doWithRetry(rq, bodyBytes)
-}
-
 type withSubaccountCBD struct {
 	CBD
 	subaccount int64
@@ -0,0 +1,177 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+
+// OpenTelemetryOptions configures WithOpenTelemetry. Zero values fall back to
+// the global providers and propagator registered with the otel package.
+type OpenTelemetryOptions struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Propagator     propagation.TextMapPropagator
+}
+
+// WithOpenTelemetry creates a client span for every API call, as a child of
+// the span found in the request context, and records request duration and
+// error metrics.
+//
+// Spans are named after the HTTP method and endpoint template, such as
+// "GET checks/{pk}/stats", and carry the response status code. When applied
+// after WithRetryPolicy and WithRateLimit/WithRateLimiter the span covers all
+// attempts and records the number of retries and the time spent waiting on
+// the rate limiter; applied before them, every attempt gets its own span.
+func WithOpenTelemetry(opts OpenTelemetryOptions) Option {
+	return func(cbd CBD) (CBD, error) {
+		if opts.TracerProvider == nil {
+			opts.TracerProvider = otel.GetTracerProvider()
+		}
+		if opts.MeterProvider == nil {
+			opts.MeterProvider = otel.GetMeterProvider()
+		}
+		if opts.Propagator == nil {
+			opts.Propagator = otel.GetTextMapPropagator()
+		}
+		meter := opts.MeterProvider.Meter(instrumentationName)
+		duration, err := meter.Float64Histogram("upapi.client.request.duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of Uptime.com API calls, including retries."),
+		)
+		if err != nil {
+			return nil, err
+		}
+		requests, err := meter.Int64Counter("upapi.client.requests",
+			metric.WithDescription("Number of Uptime.com API calls."),
+		)
+		if err != nil {
+			return nil, err
+		}
+		errs, err := meter.Int64Counter("upapi.client.errors",
+			metric.WithDescription("Number of failed Uptime.com API calls."),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &withOpenTelemetryCBD{
+			CBD:        cbd,
+			tracer:     opts.TracerProvider.Tracer(instrumentationName),
+			propagator: opts.Propagator,
+			duration:   duration,
+			requests:   requests,
+			errors:     errs,
+		}, nil
+	}
+}
+
+type withOpenTelemetryCBD struct {
+	CBD
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	duration   metric.Float64Histogram
+	requests   metric.Int64Counter
+	errors     metric.Int64Counter
+}
+
+func (o *withOpenTelemetryCBD) Do(rq *http.Request) (*http.Response, error) {
+	tmpl := EndpointTemplate(RequestEndpoint(rq))
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", rq.Method),
+		attribute.String("upapi.endpoint", tmpl),
+	}
+
+	ctx, span := o.tracer.Start(rq.Context(), fmt.Sprintf("%s %s", rq.Method, tmpl),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(attribute.String("url.full", rq.URL.String())),
+	)
+	defer span.End()
+	if attempt := RetryAttempt(ctx); attempt > 0 {
+		span.SetAttributes(attribute.Int("upapi.attempt", attempt))
+	}
+
+	stats := &callStats{}
+	ctx = context.WithValue(ctx, callStatsCtxKey{}, stats)
+	rq = rq.Clone(ctx)
+	o.propagator.Inject(ctx, propagation.HeaderCarrier(rq.Header))
+
+	start := time.Now()
+	rs, err := o.CBD.Do(rq)
+	elapsed := time.Since(start)
+
+	retries, wait := stats.snapshot()
+	span.SetAttributes(
+		attribute.Int("upapi.retry.count", retries),
+		attribute.Float64("upapi.ratelimit.wait", wait.Seconds()),
+	)
+
+	failed := err != nil
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		attrs = append(attrs, attribute.String("error.type", fmt.Sprintf("%T", err)))
+	} else {
+		span.SetAttributes(attribute.Int("http.response.status_code", rs.StatusCode))
+		attrs = append(attrs, attribute.Int("http.response.status_code", rs.StatusCode))
+		if rs.StatusCode >= http.StatusBadRequest {
+			failed = true
+			span.SetStatus(codes.Error, rs.Status)
+		}
+	}
+
+	mattrs := metric.WithAttributes(attrs...)
+	o.duration.Record(ctx, elapsed.Seconds(), mattrs)
+	o.requests.Add(ctx, 1, mattrs)
+	if failed {
+		o.errors.Add(ctx, 1, mattrs)
+	}
+	return rs, err
+}
+
+type callStatsCtxKey struct{}
+
+// callStats collects what happens to a request below an observing decorator
+// such as WithOpenTelemetry.
+type callStats struct {
+	mu      sync.Mutex
+	retries int
+	wait    time.Duration
+}
+
+func (s *callStats) snapshot() (int, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.retries, s.wait
+}
+
+func reportRetry(ctx context.Context, e RetryEvent) {
+	if s, ok := ctx.Value(callStatsCtxKey{}).(*callStats); ok {
+		s.mu.Lock()
+		s.retries++
+		s.mu.Unlock()
+	}
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+		attribute.Int("upapi.attempt", e.Attempt),
+		attribute.Float64("upapi.retry.delay", e.Delay.Seconds()),
+	))
+}
+
+func reportRateLimitWait(ctx context.Context, d time.Duration) {
+	if s, ok := ctx.Value(callStatsCtxKey{}).(*callStats); ok {
+		s.mu.Lock()
+		s.wait += d
+		s.mu.Unlock()
+	}
+}
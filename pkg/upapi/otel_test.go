@@ -0,0 +1,248 @@
+package upapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestWithOpenTelemetry(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NotEmpty(t, r.Header.Get("Traceparent"))
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = io.WriteString(w, `{"start_date": "", "end_date": "", "statistics": []}`)
+	}))
+	defer srv.Close()
+
+	tp := &recordingTracerProvider{}
+	mp := &recordingMeterProvider{}
+
+	api, err := New(
+		WithBaseURL(srv.URL+"/api/v1/"),
+		WithRateLimit(1000),
+		WithRetry(3, 0, nil),
+		WithOpenTelemetry(OpenTelemetryOptions{
+			TracerProvider: tp,
+			MeterProvider:  mp,
+			Propagator:     propagation.TraceContext{},
+		}),
+	)
+	require.NoError(t, err)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, err = api.Checks().Stats(ctx, PrimaryKey(42), CheckStatsOptions{})
+	require.NoError(t, err)
+	parent.End()
+
+	ended := tp.ended()
+	require.Len(t, ended, 2)
+	span := ended[0]
+	require.Equal(t, "GET checks/{pk}/stats", span.name)
+	require.Equal(t, parent.SpanContext().SpanID(), span.parent.SpanID())
+	require.Contains(t, span.attrs, attribute.Int("http.response.status_code", http.StatusOK))
+	require.Contains(t, span.attrs, attribute.Int("upapi.retry.count", 1))
+	require.Contains(t, span.attrs, attribute.String("upapi.endpoint", "checks/{pk}/stats"))
+	require.Equal(t, []string{"retry"}, span.events)
+
+	recorded := mp.recorded()
+	require.Equal(t, 1, recorded["upapi.client.request.duration"])
+	require.Equal(t, 1, recorded["upapi.client.requests"])
+	require.Zero(t, recorded["upapi.client.errors"])
+}
+
+func TestWithOpenTelemetryError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	tp := &recordingTracerProvider{}
+
+	api, err := New(
+		WithBaseURL(srv.URL+"/api/v1/"),
+		WithOpenTelemetry(OpenTelemetryOptions{TracerProvider: tp}),
+	)
+	require.NoError(t, err)
+
+	_, err = api.Contacts().Get(context.Background(), PrimaryKey(1))
+	require.Error(t, err)
+
+	ended := tp.ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "GET contacts/{pk}", ended[0].name)
+	require.Equal(t, codes.Error, ended[0].status)
+}
+
+// recordingTracerProvider records the spans of its tracers, so that the
+// library only needs the OpenTelemetry API and not its SDK.
+type recordingTracerProvider struct {
+	tracenoop.TracerProvider
+
+	mu     sync.Mutex
+	nextID uint64
+	// spans holds the ended spans in the order they ended.
+	spans []*recordedSpan
+}
+
+func (p *recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return recordingTracer{provider: p}
+}
+
+func (p *recordingTracerProvider) ended() []*recordedSpan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*recordedSpan(nil), p.spans...)
+}
+
+type recordingTracer struct {
+	tracenoop.Tracer
+	provider *recordingTracerProvider
+}
+
+func (t recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	p := t.provider
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextID++
+	parent := trace.SpanContextFromContext(ctx)
+	traceID := parent.TraceID()
+	if !traceID.IsValid() {
+		traceID = trace.TraceID{0: 1, 15: byte(p.nextID)}
+	}
+	cfg := trace.NewSpanStartConfig(opts...)
+	span := &recordedSpan{
+		provider: p,
+		name:     name,
+		parent:   parent,
+		sc: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     trace.SpanID{7: byte(p.nextID)},
+			TraceFlags: trace.FlagsSampled,
+		}),
+		attrs: cfg.Attributes(),
+	}
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordedSpan struct {
+	tracenoop.Span
+	provider *recordingTracerProvider
+
+	name   string
+	sc     trace.SpanContext
+	parent trace.SpanContext
+	attrs  []attribute.KeyValue
+	events []string
+	status codes.Code
+}
+
+func (s *recordedSpan) SpanContext() trace.SpanContext { return s.sc }
+
+func (s *recordedSpan) IsRecording() bool { return true }
+
+func (s *recordedSpan) SetAttributes(attrs ...attribute.KeyValue) {
+	s.provider.mu.Lock()
+	defer s.provider.mu.Unlock()
+	s.attrs = append(s.attrs, attrs...)
+}
+
+func (s *recordedSpan) AddEvent(name string, _ ...trace.EventOption) {
+	s.provider.mu.Lock()
+	defer s.provider.mu.Unlock()
+	s.events = append(s.events, name)
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) {
+	s.provider.mu.Lock()
+	defer s.provider.mu.Unlock()
+	s.status = code
+}
+
+func (s *recordedSpan) End(...trace.SpanEndOption) {
+	s.provider.mu.Lock()
+	defer s.provider.mu.Unlock()
+	s.provider.spans = append(s.provider.spans, s)
+}
+
+// recordingMeterProvider counts the measurements made with the instruments of
+// its meters, by instrument name.
+type recordingMeterProvider struct {
+	metricnoop.MeterProvider
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (p *recordingMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return recordingMeter{provider: p}
+}
+
+func (p *recordingMeterProvider) record(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.counts == nil {
+		p.counts = make(map[string]int)
+	}
+	p.counts[name]++
+}
+
+func (p *recordingMeterProvider) recorded() map[string]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	counts := make(map[string]int, len(p.counts))
+	for k, v := range p.counts {
+		counts[k] = v
+	}
+	return counts
+}
+
+type recordingMeter struct {
+	metricnoop.Meter
+	provider *recordingMeterProvider
+}
+
+func (m recordingMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return recordingHistogram{name: name, provider: m.provider}, nil
+}
+
+func (m recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return recordingCounter{name: name, provider: m.provider}, nil
+}
+
+type recordingHistogram struct {
+	metricnoop.Float64Histogram
+	name     string
+	provider *recordingMeterProvider
+}
+
+func (h recordingHistogram) Record(context.Context, float64, ...metric.RecordOption) {
+	h.provider.record(h.name)
+}
+
+type recordingCounter struct {
+	metricnoop.Int64Counter
+	name     string
+	provider *recordingMeterProvider
+}
+
+func (c recordingCounter) Add(context.Context, int64, ...metric.AddOption) {
+	c.provider.record(c.name)
+}
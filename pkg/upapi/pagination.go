@@ -0,0 +1,238 @@
+package upapi
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+const defaultIteratePageSize = 100
+
+// Lister is implemented by every endpoint exposing a paginated List method,
+// including nested endpoints such as StatusPages().Components(pk).
+type Lister[Item any, Options any] interface {
+	List(context.Context, Options) (*ListResult[Item], error)
+}
+
+// IterateOption tunes the behaviour of Iterate and ListAll.
+type IterateOption func(*iterateConfig)
+
+type iterateConfig struct {
+	pageSize    int64
+	concurrency int
+}
+
+// WithPageSize sets the number of items requested per page. When not given,
+// the PageSize of the list options is used, falling back to 100. When the
+// server caps the size of pages below it, pages are walked by that size.
+func WithPageSize(size int64) IterateOption {
+	return func(c *iterateConfig) {
+		c.pageSize = size
+	}
+}
+
+// WithConcurrency allows up to n pages to be fetched in parallel once the
+// total item count is known from the first page. Items are still delivered
+// in page order.
+func WithConcurrency(n int) IterateOption {
+	return func(c *iterateConfig) {
+		c.concurrency = n
+	}
+}
+
+// Iterate walks every page of the listing and calls fn for each item in
+// order. The Page and PageSize fields of opts are managed by Iterate; list
+// options without such fields are fetched with a single List call.
+// Iteration stops at the first error returned by the endpoint or fn, or
+// when ctx is cancelled.
+func Iterate[Item any, Options any](ctx context.Context, l Lister[Item, Options], opts Options, fn func(Item) error, iopts ...IterateOption) error {
+	cfg := iterateConfig{concurrency: 1}
+	if size, ok := pageSizeOf(opts); ok && size > 0 {
+		cfg.pageSize = size
+	}
+	for i := range iopts {
+		iopts[i](&cfg)
+	}
+	if cfg.pageSize <= 0 {
+		cfg.pageSize = defaultIteratePageSize
+	}
+	if cfg.concurrency < 1 {
+		cfg.concurrency = 1
+	}
+
+	first, paginated := withPage(opts, 1, cfg.pageSize)
+	result, err := l.List(ctx, first)
+	if err != nil {
+		return err
+	}
+	if err = yieldAll(result.Items, fn); err != nil {
+		return err
+	}
+	if !paginated {
+		return nil
+	}
+
+	// Servers cap the page size: number the next pages by the size of the
+	// first one when it came back short.
+	size := cfg.pageSize
+	if n := int64(len(result.Items)); n > 0 && n < size && n < result.TotalCount {
+		size = n
+	}
+	seen, page := int64(len(result.Items)), int64(2)
+	if pages := (result.TotalCount + size - 1) / size; cfg.concurrency > 1 && pages > 2 {
+		n, err := iterateConcurrently(ctx, l, opts, fn, cfg.concurrency, size, pages)
+		if err != nil {
+			return err
+		}
+		seen, page = seen+n, pages+1
+	}
+
+	// Pages fetched concurrently may come back short too, when items are
+	// deleted meanwhile: the rest is fetched sequentially.
+	for ; seen < result.TotalCount && len(result.Items) > 0; page++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		next, _ := withPage(opts, page, size)
+		result, err = l.List(ctx, next)
+		if err != nil {
+			return err
+		}
+		if err = yieldAll(result.Items, fn); err != nil {
+			return err
+		}
+		seen += int64(len(result.Items))
+	}
+	return nil
+}
+
+// ListAll collects every item of the listing. See Iterate for details.
+func ListAll[Item any, Options any](ctx context.Context, l Lister[Item, Options], opts Options, iopts ...IterateOption) ([]Item, error) {
+	var items []Item
+	err := Iterate(ctx, l, opts, func(item Item) error {
+		items = append(items, item)
+		return nil
+	}, iopts...)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type pageResult[Item any] struct {
+	items []Item
+	err   error
+}
+
+// iterateConcurrently fetches pages 2 to pages of size items, up to
+// concurrency at a time, and returns the number of items passed to fn.
+func iterateConcurrently[Item any, Options any](ctx context.Context, l Lister[Item, Options], opts Options, fn func(Item) error, concurrency int, size, pages int64) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Slot i receives page i+2; the consumer below drains slots in order so
+	// items are delivered the same way a sequential walk would.
+	slots := make([]chan pageResult[Item], pages-1)
+	for i := range slots {
+		slots[i] = make(chan pageResult[Item], 1)
+	}
+
+	// The producer must see the cancellation before it is waited for, or it
+	// would go on fetching every remaining page after an error.
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	sem := make(chan struct{}, concurrency)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range slots {
+			select {
+			case <-ctx.Done():
+				slots[i] <- pageResult[Item]{err: ctx.Err()}
+				continue
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				rq, _ := withPage(opts, int64(i)+2, size)
+				result, err := l.List(ctx, rq)
+				if err != nil {
+					slots[i] <- pageResult[Item]{err: err}
+					return
+				}
+				slots[i] <- pageResult[Item]{items: result.Items}
+			}(i)
+		}
+	}()
+
+	var seen int64
+	for i := range slots {
+		var res pageResult[Item]
+		select {
+		case <-ctx.Done():
+			return seen, ctx.Err()
+		case res = <-slots[i]:
+		}
+		if res.err != nil {
+			return seen, res.err
+		}
+		if err := yieldAll(res.items, fn); err != nil {
+			return seen, err
+		}
+		seen += int64(len(res.items))
+	}
+	return seen, nil
+}
+
+func yieldAll[Item any](items []Item, fn func(Item) error) error {
+	for i := range items {
+		if err := fn(items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withPage returns a copy of opts with its Page and PageSize fields set. The
+// second return value reports whether opts has such fields at all.
+func withPage[Options any](opts Options, page, size int64) (Options, bool) {
+	v := reflect.ValueOf(&opts).Elem()
+	if v.Kind() != reflect.Struct {
+		return opts, false
+	}
+	pf, sf := v.FieldByName("Page"), v.FieldByName("PageSize")
+	if !isIntField(pf) || !isIntField(sf) {
+		return opts, false
+	}
+	pf.SetInt(page)
+	sf.SetInt(size)
+	return opts, true
+}
+
+func pageSizeOf[Options any](opts Options) (int64, bool) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	sf := v.FieldByName("PageSize")
+	if !isIntField(sf) {
+		return 0, false
+	}
+	return sf.Int(), true
+}
+
+func isIntField(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
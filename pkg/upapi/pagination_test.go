@@ -0,0 +1,212 @@
+package upapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testPagedOptions struct {
+	Page     int64  `url:"page,omitempty"`
+	PageSize int64  `url:"page_size,omitempty"`
+	Search   string `url:"search,omitempty"`
+}
+
+func newPagedServer(t *testing.T, total int, calls *int32) *httptest.Server {
+	return newCappedPagedServer(t, total, 0, calls)
+}
+
+// newCappedPagedServer serves pages of at most maxSize items, whatever the
+// page size requested, when maxSize is not zero.
+func newCappedPagedServer(t *testing.T, total, maxSize int, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		require.Equal(t, "/api/v1/items/", r.URL.Path)
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		require.NoError(t, err)
+		size, err := strconv.Atoi(r.URL.Query().Get("page_size"))
+		require.NoError(t, err)
+		if maxSize > 0 {
+			size = min(size, maxSize)
+		}
+		rs := testListResponse{Count: total, Results: []testItem{}}
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			rs.Results = append(rs.Results, testItem{Bar: i})
+		}
+		_ = json.NewEncoder(w).Encode(rs)
+	}))
+}
+
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+
+	var calls int32
+	srv := newPagedServer(t, 25, &calls)
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+	ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+	items, err := ListAll[testItem, testPagedOptions](ctx, ep, testPagedOptions{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 25)
+	for i := range items {
+		require.Equal(t, i, items[i].Bar)
+	}
+	require.Equal(t, int32(3), calls)
+}
+
+func TestListAllConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	var calls int32
+	srv := newPagedServer(t, 95, &calls)
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+	ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+	items, err := ListAll[testItem, testPagedOptions](ctx, ep, testPagedOptions{}, WithPageSize(10), WithConcurrency(4))
+	require.NoError(t, err)
+	require.Len(t, items, 95)
+	for i := range items {
+		require.Equal(t, i, items[i].Bar)
+	}
+	require.Equal(t, int32(10), calls)
+}
+
+func TestListAllServerCappedPageSize(t *testing.T) {
+	ctx := context.Background()
+
+	for _, concurrency := range []int{1, 4} {
+		var calls int32
+		srv := newCappedPagedServer(t, 95, 8, &calls)
+		defer srv.Close()
+
+		cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+		require.NoError(t, err)
+		ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+		items, err := ListAll[testItem, testPagedOptions](ctx, ep, testPagedOptions{}, WithPageSize(10), WithConcurrency(concurrency))
+		require.NoError(t, err)
+		require.Len(t, items, 95, "concurrency %d", concurrency)
+		for i := range items {
+			require.Equal(t, i, items[i].Bar)
+		}
+		require.Equal(t, int32(12), calls)
+	}
+}
+
+func TestIterateStopsOnError(t *testing.T) {
+	ctx := context.Background()
+
+	var calls int32
+	srv := newPagedServer(t, 50, &calls)
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+	ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+	stop := errors.New("stop")
+	seen := 0
+	err = Iterate[testItem, testPagedOptions](ctx, ep, testPagedOptions{PageSize: 10}, func(item testItem) error {
+		seen++
+		if item.Bar == 14 {
+			return stop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, stop)
+	require.Equal(t, 15, seen)
+	require.Equal(t, int32(2), calls)
+}
+
+func TestIterateConcurrentStopsOnError(t *testing.T) {
+	ctx := context.Background()
+
+	var calls int32
+	srv := newPagedServer(t, 100, &calls)
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+	ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+	stop := errors.New("stop")
+	err = Iterate[testItem, testPagedOptions](ctx, ep, testPagedOptions{}, func(item testItem) error {
+		if item.Bar == 1 {
+			return stop
+		}
+		return nil
+	}, WithPageSize(1), WithConcurrency(2))
+	require.ErrorIs(t, err, stop)
+	// The pages already requested may complete, the others are not fetched.
+	require.LessOrEqual(t, atomic.LoadInt32(&calls), int32(5))
+}
+
+func TestIterateContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int32
+	srv := newPagedServer(t, 50, &calls)
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+	ep := NewEndpointLister[testListResponse, testItem, testPagedOptions](cbd, "items")
+
+	err = Iterate[testItem, testPagedOptions](ctx, ep, testPagedOptions{PageSize: 10}, func(item testItem) error {
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, int32(1), calls)
+}
+
+func TestListAllUnpaginated(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.RawQuery)
+		_, _ = w.Write([]byte(`{"locations": ["US-East", "US-West"]}`))
+	}))
+	defer srv.Close()
+
+	cbd, err := WithBaseURL(srv.URL + "/api/v1/")(testCBD)
+	require.NoError(t, err)
+
+	ep := NewEndpointLister[CheckLocationListResponse, string, CheckLocationListOptions](cbd, "checks/locations")
+	items, err := ListAll[string, CheckLocationListOptions](ctx, ep, CheckLocationListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"US-East", "US-West"}, items)
+}
+
+func TestListAllEndpoint(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/check-tags/", r.URL.Path)
+		require.Equal(t, "1", r.URL.Query().Get("page"))
+		require.Equal(t, "prod", r.URL.Query().Get("search"))
+		_, _ = w.Write([]byte(`{"count": 1, "results": [{"pk": 1, "tag": "prod"}]}`))
+	}))
+	defer srv.Close()
+
+	api, err := New(WithBaseURL(srv.URL + "/api/v1/"))
+	require.NoError(t, err)
+
+	tags, err := ListAll[Tag, TagListOptions](ctx, api.Tags(), TagListOptions{Search: "prod"})
+	require.NoError(t, err)
+	require.Equal(t, []Tag{{PK: 1, Tag: "prod"}}, tags)
+}
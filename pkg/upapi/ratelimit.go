@@ -0,0 +1,220 @@
+package upapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter is an adaptive client-side rate limiter. It starts at the
+// configured maximum rate and adjusts itself from the rate-limit headers and
+// 429 responses the server sends back:
+//
+//   - X-RateLimit-Remaining / X-RateLimit-Reset (or the unprefixed
+//     RateLimit-* variants) spread the remaining budget evenly until the
+//     reset, and pause all requests once the budget is exhausted;
+//   - a 429 response halves the current rate and pauses until Retry-After
+//     (or the advertised reset) has passed;
+//   - responses without rate-limit headers let the rate recover gradually
+//     back to the maximum.
+//
+// A single RateLimiter may be shared between several API instances, e.g. one
+// per subaccount using the same token, via WithRateLimiter.
+type RateLimiter struct {
+	limiter *rate.Limiter
+
+	mu          sync.Mutex
+	maxRate     float64
+	minRate     float64
+	rate        float64
+	serverLimit int
+	remaining   int
+	reset       time.Time
+	pausedUntil time.Time
+	requests    int64
+	throttled   int64
+}
+
+// RateLimiterState is a snapshot of the RateLimiter internals, suitable for
+// exporting as metrics.
+type RateLimiterState struct {
+	Rate        float64   // current requests per second
+	MaxRate     float64   // configured ceiling
+	ServerLimit int       // last advertised limit, -1 when unknown
+	Remaining   int       // last advertised remaining budget, -1 when unknown
+	Reset       time.Time // last advertised budget reset, zero when unknown
+	PausedUntil time.Time // requests are held until this time
+	Requests    int64     // responses observed
+	Throttled   int64     // 429 responses observed
+}
+
+// NewRateLimiter returns a RateLimiter allowing at most maxRate requests per
+// second with the given burst.
+func NewRateLimiter(maxRate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		limiter:     rate.NewLimiter(rate.Limit(maxRate), burst),
+		maxRate:     maxRate,
+		minRate:     maxRate / 100,
+		rate:        maxRate,
+		serverLimit: -1,
+		remaining:   -1,
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// Observe adapts the limiter to a response received from the server.
+func (l *RateLimiter) Observe(rs *http.Response) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.requests++
+	if n, ok := rateLimitHeaderInt(rs.Header, "Limit"); ok {
+		l.serverLimit = n
+	}
+	remaining, hasRemaining := rateLimitHeaderInt(rs.Header, "Remaining")
+	if hasRemaining {
+		l.remaining = remaining
+	}
+	reset, hasReset := rateLimitReset(rs.Header, now)
+	if hasReset {
+		l.reset = reset
+	}
+
+	switch {
+	case rs.StatusCode == http.StatusTooManyRequests:
+		l.throttled++
+		until := now.Add(time.Second)
+		if d, ok := parseRetryAfter(rs.Header.Get("Retry-After"), now); ok {
+			until = now.Add(d)
+		} else if hasReset {
+			until = reset
+		}
+		l.pause(until)
+		l.setRate(l.rate / 2)
+	case hasRemaining && hasReset:
+		if remaining <= 0 {
+			l.pause(reset)
+		}
+		if window := reset.Sub(now).Seconds(); window > 0 && remaining > 0 {
+			l.setRate(float64(remaining) / window)
+		} else {
+			l.setRate(l.maxRate)
+		}
+	default:
+		l.setRate(l.rate + l.maxRate/10)
+	}
+}
+
+// State returns a snapshot of the limiter state.
+func (l *RateLimiter) State() RateLimiterState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return RateLimiterState{
+		Rate:        l.rate,
+		MaxRate:     l.maxRate,
+		ServerLimit: l.serverLimit,
+		Remaining:   l.remaining,
+		Reset:       l.reset,
+		PausedUntil: l.pausedUntil,
+		Requests:    l.requests,
+		Throttled:   l.throttled,
+	}
+}
+
+func (l *RateLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+func (l *RateLimiter) setRate(r float64) {
+	r = math.Max(l.minRate, math.Min(l.maxRate, r))
+	if r == l.rate {
+		return
+	}
+	l.rate = r
+	l.limiter.SetLimit(rate.Limit(r))
+}
+
+func rateLimitHeader(h http.Header, name string) string {
+	if v := h.Get("X-RateLimit-" + name); v != "" {
+		return v
+	}
+	return h.Get("RateLimit-" + name)
+}
+
+func rateLimitHeaderInt(h http.Header, name string) (int, bool) {
+	n, err := strconv.Atoi(rateLimitHeader(h, name))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// rateLimitReset interprets the reset header which is either a number of
+// seconds until the reset or, for large values, a unix timestamp.
+func rateLimitReset(h http.Header, now time.Time) (time.Time, bool) {
+	v, err := strconv.ParseFloat(rateLimitHeader(h, "Reset"), 64)
+	if err != nil || v < 0 {
+		return time.Time{}, false
+	}
+	if v > 1e9 {
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)), true
+	}
+	return now.Add(time.Duration(v * float64(time.Second))), true
+}
+
+// WithRateLimiter throttles requests through l, which may be shared between
+// several API instances. Apply it before WithRetryPolicy so every attempt is
+// accounted for.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(cbd CBD) (CBD, error) {
+		return &withRateLimiterCBD{cbd, l}, nil
+	}
+}
+
+type withRateLimiterCBD struct {
+	CBD
+	limiter *RateLimiter
+}
+
+func (s *withRateLimiterCBD) Do(rq *http.Request) (*http.Response, error) {
+	start := time.Now()
+	if err := s.limiter.Wait(rq.Context()); err != nil {
+		return nil, err
+	}
+	reportRateLimitWait(rq.Context(), time.Since(start))
+	rs, err := s.CBD.Do(rq)
+	if err != nil {
+		return nil, err
+	}
+	s.limiter.Observe(rs)
+	return rs, nil
+}
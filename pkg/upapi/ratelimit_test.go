@@ -0,0 +1,83 @@
+package upapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterRemainingBudget(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+
+	l.Observe(testResponse(http.StatusOK, http.Header{
+		"X-Ratelimit-Limit":     []string{"600"},
+		"X-Ratelimit-Remaining": []string{"10"},
+		"X-Ratelimit-Reset":     []string{"5"},
+	}))
+	st := l.State()
+	require.Equal(t, 600, st.ServerLimit)
+	require.Equal(t, 10, st.Remaining)
+	require.InDelta(t, 2.0, st.Rate, 0.1)
+	require.True(t, st.PausedUntil.IsZero())
+
+	// Without headers the rate recovers towards the maximum.
+	l.Observe(testResponse(http.StatusOK, nil))
+	require.Greater(t, l.State().Rate, st.Rate)
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	l := NewRateLimiter(10, 1)
+
+	l.Observe(testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"30"}}))
+	st := l.State()
+	require.Equal(t, int64(1), st.Throttled)
+	require.Equal(t, 5.0, st.Rate)
+	require.WithinDuration(t, time.Now().Add(30*time.Second), st.PausedUntil, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestWithRateLimiterShared(t *testing.T) {
+	l := NewRateLimiter(1000, 1)
+
+	exhausted := testResponse(http.StatusOK, http.Header{
+		"Ratelimit-Remaining": []string{"0"},
+		"Ratelimit-Reset":     []string{"0.2"},
+	})
+	cbdm1 := new(cbdMock)
+	cbdm1.On("Do", mock.Anything).Return(exhausted, nil).Once()
+	cbdm2 := new(cbdMock)
+	cbdm2.On("Do", mock.Anything).Return(testResponse(http.StatusOK, nil), nil).Once()
+
+	cbd1, err := WithRateLimiter(l)(cbdm1)
+	require.NoError(t, err)
+	cbd2, err := WithRateLimiter(l)(cbdm2)
+	require.NoError(t, err)
+
+	rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+	_, err = cbd1.Do(rq)
+	require.NoError(t, err)
+
+	// The budget exhausted through the first client holds back the second.
+	now := time.Now()
+	_, err = cbd2.Do(rq)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(now), 150*time.Millisecond)
+
+	require.Equal(t, int64(2), l.State().Requests)
+	cbdm1.AssertExpectations(t)
+	cbdm2.AssertExpectations(t)
+}
+
+func TestRateLimitResetEpoch(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset, ok := rateLimitReset(http.Header{"X-Ratelimit-Reset": []string{"1700000060"}}, now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(time.Minute), reset)
+}
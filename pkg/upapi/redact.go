@@ -0,0 +1,176 @@
+package upapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "[REDACTED]"
+
+type redactCtxKey struct{}
+
+// withRedacted returns ctx with the fields tagged `redact:"true"` in the types
+// given added to those masked in the logged or recorded bodies of the request
+// built with ctx and of its response. Endpoints add the types of the bodies
+// they send and decode, so that only the secrets of those are masked.
+func withRedacted(ctx context.Context, types ...reflect.Type) context.Context {
+	keys := redactedKeys(ctx)
+	var merged map[string]bool
+	for _, t := range types {
+		for k := range sensitiveKeysOf(t) {
+			if keys[k] || merged[k] {
+				continue
+			}
+			if merged == nil {
+				merged = make(map[string]bool, len(keys)+1)
+				for k := range keys {
+					merged[k] = true
+				}
+			}
+			merged[k] = true
+		}
+	}
+	if merged == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, redactCtxKey{}, merged)
+}
+
+// redactedKeys returns the JSON names of the fields masked in the bodies of a
+// request built with ctx and of its response.
+func redactedKeys(ctx context.Context) map[string]bool {
+	keys, _ := ctx.Value(redactCtxKey{}).(map[string]bool)
+	return keys
+}
+
+// typeOf returns the type T, for withRedacted.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+var sensitiveKeysCache sync.Map
+
+// sensitiveKeysOf returns the JSON names of the fields of t tagged
+// `redact:"true"`, at any depth.
+func sensitiveKeysOf(t reflect.Type) map[string]bool {
+	if keys, ok := sensitiveKeysCache.Load(t); ok {
+		return keys.(map[string]bool)
+	}
+	keys := make(map[string]bool)
+	collectSensitiveKeys(t, keys, make(map[reflect.Type]bool))
+	sensitiveKeysCache.Store(t, keys)
+	return keys
+}
+
+func collectSensitiveKeys(t reflect.Type, keys map[string]bool, seen map[reflect.Type]bool) {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if f.Tag.Get("redact") == "true" {
+			keys[name] = true
+		}
+		collectSensitiveKeys(f.Type, keys, seen)
+	}
+}
+
+// redactBody masks the fields of a JSON body named in keys. Bodies which are
+// not JSON are returned unchanged.
+func redactBody(keys map[string]bool, body []byte) []byte {
+	if len(keys) == 0 || len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	if !redactValue(v, keys) {
+		return body
+	}
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return []byte(redactedValue)
+	}
+	return buf.Bytes()
+}
+
+// redactValue masks sensitive keys of decoded JSON in place and reports
+// whether anything was masked.
+func redactValue(v any, keys map[string]bool) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for k := range v {
+			if keys[k] {
+				if v[k] != nil && v[k] != "" {
+					v[k] = redactedValue
+					changed = true
+				}
+				continue
+			}
+			changed = redactValue(v[k], keys) || changed
+		}
+	case []any:
+		for i := range v {
+			changed = redactValue(v[i], keys) || changed
+		}
+	}
+	return changed
+}
+
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// redactHeader returns a copy of h with credentials masked.
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for k, v := range out {
+		out[k] = redactHeaderValues(k, v)
+	}
+	return out
+}
+
+// redactHeaderValues returns values of the header key with credentials
+// masked. The authentication scheme, e.g. Token or Bearer, is preserved.
+// values is never modified.
+func redactHeaderValues(key string, values []string) []string {
+	key = http.CanonicalHeaderKey(key)
+	if !sensitiveHeaders[key] {
+		return values
+	}
+	out := make([]string, len(values))
+	for i := range values {
+		out[i] = redactedValue
+		if strings.HasSuffix(key, "Authorization") {
+			if scheme, _, ok := strings.Cut(values[i], " "); ok {
+				out[i] = scheme + " " + redactedValue
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,227 @@
+package upapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrAmbiguous is matched by *ResolveError through errors.Is when a name
+// designates several resources.
+var ErrAmbiguous = errors.New("ambiguous name")
+
+// Resource identifies a kind of resource a Resolver looks up.
+type Resource string
+
+const (
+	ResourceCheck       Resource = "check"
+	ResourceContact     Resource = "contact"
+	ResourceTag         Resource = "tag"
+	ResourceIntegration Resource = "integration"
+	ResourceStatusPage  Resource = "status page"
+	ResourceCredential  Resource = "credential"
+)
+
+// ResolveError is returned by Resolver when a name matches no resource,
+// matched by ErrNotFound, or several, matched by ErrAmbiguous.
+type ResolveError struct {
+	Resource Resource
+	Name     string
+	// Matches holds the primary keys of the resources sharing Name.
+	Matches []PrimaryKey
+}
+
+func (e *ResolveError) Error() string {
+	if len(e.Matches) == 0 {
+		return fmt.Sprintf("%s %q not found", e.Resource, e.Name)
+	}
+	pks := make([]string, len(e.Matches))
+	for i, pk := range e.Matches {
+		pks[i] = strconv.Itoa(int(pk))
+	}
+	return fmt.Sprintf("%s name %q is ambiguous, use one of the primary keys %s", e.Resource, e.Name, strings.Join(pks, ", "))
+}
+
+func (e *ResolveError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return len(e.Matches) == 0
+	case ErrAmbiguous:
+		return len(e.Matches) > 1
+	}
+	return false
+}
+
+// Resolver translates resource names to primary keys and back, for checks,
+// contacts, tags, integrations, status pages and credentials. Each kind of
+// resource is listed once, on first use, and served from memory afterwards;
+// call Invalidate after changing resources through other means.
+//
+// A Resolver is safe for concurrent use.
+type Resolver struct {
+	api API
+
+	mu      sync.Mutex
+	indexes map[Resource]*resolverIndex
+}
+
+type resolverIndex struct {
+	byName map[string][]PrimaryKey
+	byPK   map[PrimaryKey]string
+}
+
+// NewResolver returns a Resolver looking resources up through api.
+func NewResolver(api API) *Resolver {
+	return &Resolver{
+		api:     api,
+		indexes: make(map[Resource]*resolverIndex),
+	}
+}
+
+// PK returns the primary key of the resource named name. Callers may pass a
+// primary key instead, prefixed with "pk:" as in "pk:123", which is returned
+// as is. A name made of digits only is taken as a primary key when a resource
+// has it, then looked up as a name, and otherwise returned as is, so that
+// resources named like "2024" can be found.
+func (r *Resolver) PK(ctx context.Context, res Resource, name string) (PrimaryKey, error) {
+	if s, ok := strings.CutPrefix(name, "pk:"); ok {
+		pk, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s primary key %q", res, s)
+		}
+		return PrimaryKey(pk), nil
+	}
+	idx, err := r.index(ctx, res)
+	if err != nil {
+		return 0, err
+	}
+	pks := idx.byName[name]
+	if pk, err := strconv.ParseInt(name, 10, 64); err == nil {
+		if _, ok := idx.byPK[PrimaryKey(pk)]; ok || len(pks) == 0 {
+			return PrimaryKey(pk), nil
+		}
+	}
+	if len(pks) != 1 {
+		return 0, &ResolveError{Resource: res, Name: name, Matches: pks}
+	}
+	return pks[0], nil
+}
+
+// PKs resolves every name with PK.
+func (r *Resolver) PKs(ctx context.Context, res Resource, names []string) ([]PrimaryKey, error) {
+	pks := make([]PrimaryKey, len(names))
+	for i, name := range names {
+		pk, err := r.PK(ctx, res, name)
+		if err != nil {
+			return nil, err
+		}
+		pks[i] = pk
+	}
+	return pks, nil
+}
+
+// Name returns the name of the resource with primary key pk.
+func (r *Resolver) Name(ctx context.Context, res Resource, pk PrimaryKeyable) (string, error) {
+	idx, err := r.index(ctx, res)
+	if err != nil {
+		return "", err
+	}
+	name, ok := idx.byPK[pk.PrimaryKey()]
+	if !ok {
+		return "", &ResolveError{Resource: res, Name: strconv.Itoa(int(pk.PrimaryKey()))}
+	}
+	return name, nil
+}
+
+// Invalidate drops the cached listings of the given kinds of resource, or of
+// all of them when none is given.
+func (r *Resolver) Invalidate(res ...Resource) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(res) == 0 {
+		r.indexes = make(map[Resource]*resolverIndex)
+		return
+	}
+	for _, k := range res {
+		delete(r.indexes, k)
+	}
+}
+
+func (r *Resolver) index(ctx context.Context, res Resource) (*resolverIndex, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if idx, ok := r.indexes[res]; ok {
+		return idx, nil
+	}
+	idx := &resolverIndex{
+		byName: make(map[string][]PrimaryKey),
+		byPK:   make(map[PrimaryKey]string),
+	}
+	add := func(pk int64, name string) {
+		idx.byName[name] = append(idx.byName[name], PrimaryKey(pk))
+		idx.byPK[PrimaryKey(pk)] = name
+	}
+	switch res {
+	case ResourceCheck:
+		items, err := ListAllChecks(ctx, r.api.Checks(), CheckListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.Name)
+		}
+	case ResourceContact:
+		items, err := ListAll(ctx, r.api.Contacts(), ContactListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.Name)
+		}
+	case ResourceTag:
+		items, err := ListAll(ctx, r.api.Tags(), TagListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.Tag)
+		}
+	case ResourceIntegration:
+		items, err := ListAll(ctx, r.api.Integrations(), IntegrationListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.Name)
+		}
+	case ResourceStatusPage:
+		items, err := ListAll(ctx, r.api.StatusPages(), StatusPageListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.Name)
+		}
+	case ResourceCredential:
+		items, err := ListAll(ctx, r.api.Credentials(), CredentialListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.PK, item.DisplayName)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource %q", res)
+	}
+	for _, pks := range idx.byName {
+		sort.Slice(pks, func(i, j int) bool {
+			return pks[i] < pks[j]
+		})
+	}
+	r.indexes[res] = idx
+	return idx, nil
+}
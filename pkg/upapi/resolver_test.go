@@ -0,0 +1,79 @@
+package upapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	requests := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/checks/":
+			if r.URL.Query().Get("is_paused") == "true" {
+				_, _ = w.Write([]byte(`{"count": 1, "results": [{"pk": 3, "name": "API prod"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"count": 4, "results": [{"pk": 1, "name": "web"}, {"pk": 2, "name": "dup"}, {"pk": 4, "name": "dup"},
+				{"pk": 5, "name": "2024"}]}`))
+		case "/check-tags/":
+			_, _ = w.Write([]byte(`{"count": 1, "results": [{"pk": 7, "tag": "prod"}]}`))
+		case "/credentials/":
+			_, _ = w.Write([]byte(`{"count": 1, "results": [{"id": 9, "display_name": "vault"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+	r := NewResolver(api)
+
+	pk, err := r.PK(ctx, ResourceCheck, "API prod")
+	require.NoError(t, err)
+	require.Equal(t, PrimaryKey(3), pk)
+
+	pks, err := r.PKs(ctx, ResourceCheck, []string{"web", "42"})
+	require.NoError(t, err)
+	require.Equal(t, []PrimaryKey{1, 42}, pks)
+
+	pks, err = r.PKs(ctx, ResourceCheck, []string{"2024", "4", "pk:2024"})
+	require.NoError(t, err)
+	require.Equal(t, []PrimaryKey{5, 4, 2024}, pks)
+
+	_, err = r.PK(ctx, ResourceCheck, "pk:web")
+	require.EqualError(t, err, `invalid check primary key "web"`)
+
+	_, err = r.PK(ctx, ResourceCheck, "dup")
+	require.ErrorIs(t, err, ErrAmbiguous)
+	require.EqualError(t, err, `check name "dup" is ambiguous, use one of the primary keys 2, 4`)
+
+	_, err = r.PK(ctx, ResourceCheck, "missing")
+	require.True(t, IsNotFound(err))
+
+	name, err := r.Name(ctx, ResourceCheck, PrimaryKey(1))
+	require.NoError(t, err)
+	require.Equal(t, "web", name)
+	require.Equal(t, 2, requests["/checks/"])
+
+	pk, err = r.PK(ctx, ResourceTag, "prod")
+	require.NoError(t, err)
+	require.Equal(t, PrimaryKey(7), pk)
+
+	name, err = r.Name(ctx, ResourceCredential, PrimaryKey(9))
+	require.NoError(t, err)
+	require.Equal(t, "vault", name)
+
+	r.Invalidate(ResourceCheck)
+	_, err = r.PK(ctx, ResourceCheck, "web")
+	require.NoError(t, err)
+	require.Equal(t, 4, requests["/checks/"])
+	require.Equal(t, 1, requests["/check-tags/"])
+}
@@ -0,0 +1,308 @@
+package upapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMultiplier      = 2
+	defaultRetryMaxRetries      = 5
+)
+
+// RetryPolicy decides whether a request should be attempted again.
+//
+// ShouldRetry is called after every attempt with the response or transport
+// error it produced. attempt is the 1-based number of the attempt just made
+// and elapsed is the time since the first attempt started. It returns the
+// delay before the next attempt and whether there should be one at all.
+type RetryPolicy interface {
+	ShouldRetry(rq *http.Request, rs *http.Response, err error, attempt int, elapsed time.Duration) (time.Duration, bool)
+}
+
+// RetryEvent describes a retry that is about to happen.
+type RetryEvent struct {
+	Request  *http.Request
+	Response *http.Response // nil when the attempt failed with a transport error
+	Err      error
+	Attempt  int           // the attempt that failed, starting at 1
+	Delay    time.Duration // wait before the next attempt
+}
+
+// RetryHook is invoked before sleeping ahead of each retry.
+type RetryHook func(RetryEvent)
+
+// ExponentialBackoff is the default RetryPolicy. It retries 429 responses,
+// 502/503/504 responses and transient network errors, waiting an
+// exponentially growing, jittered interval between attempts. A Retry-After
+// header, either in delay-seconds or HTTP-date form, takes precedence over
+// the computed interval.
+//
+// Requests with non-idempotent methods (POST, PATCH) are only retried when
+// the server cannot have acted on them: 429 responses and failures to
+// establish a connection. Set RetryNonIdempotent to lift this restriction.
+type ExponentialBackoff struct {
+	// InitialInterval is the delay before the first retry. Defaults to 500ms.
+	InitialInterval time.Duration
+	// MaxInterval caps every delay, including the one requested by
+	// Retry-After. When zero, computed delays are capped to 30s and
+	// Retry-After is honoured as is.
+	MaxInterval time.Duration
+	// Multiplier is the growth factor between consecutive delays. Defaults to 2.
+	Multiplier float64
+	// Jitter randomizes every delay by up to the given fraction (0..1).
+	Jitter float64
+	// MaxRetries limits the number of retries. MaxElapsedTime limits the
+	// total time spent. When both are zero, 5 retries are allowed.
+	MaxRetries     int
+	MaxElapsedTime time.Duration
+	// RetryNonIdempotent allows POST and PATCH requests to be retried on any
+	// retryable condition.
+	RetryNonIdempotent bool
+}
+
+func (b *ExponentialBackoff) ShouldRetry(rq *http.Request, rs *http.Response, err error, attempt int, elapsed time.Duration) (time.Duration, bool) {
+	maxRetries := b.MaxRetries
+	if maxRetries <= 0 && b.MaxElapsedTime <= 0 {
+		maxRetries = defaultRetryMaxRetries
+	}
+	if maxRetries > 0 && attempt > maxRetries {
+		return 0, false
+	}
+
+	retryable, safe := false, false
+	if err != nil {
+		retryable, safe = classifyTransportError(err)
+	} else if rs != nil {
+		switch rs.StatusCode {
+		case http.StatusTooManyRequests:
+			retryable, safe = true, true
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			retryable = true
+		}
+	}
+	if !retryable {
+		return 0, false
+	}
+	if !safe && !b.RetryNonIdempotent && !isIdempotent(rq.Method) {
+		return 0, false
+	}
+
+	delay, ok := time.Duration(0), false
+	if rs != nil {
+		delay, ok = parseRetryAfter(rs.Header.Get("Retry-After"), time.Now())
+	}
+	if !ok {
+		delay = b.backoff(attempt)
+	}
+	if b.MaxInterval > 0 && delay > b.MaxInterval {
+		delay = b.MaxInterval
+	}
+	if b.MaxElapsedTime > 0 && elapsed+delay > b.MaxElapsedTime {
+		return 0, false
+	}
+	return delay, true
+}
+
+func (b *ExponentialBackoff) backoff(attempt int) time.Duration {
+	initial, mult, max := b.InitialInterval, b.Multiplier, b.MaxInterval
+	if initial <= 0 {
+		initial = defaultRetryInitialInterval
+	}
+	if mult < 1 {
+		mult = defaultRetryMultiplier
+	}
+	if max <= 0 {
+		max = defaultRetryMaxInterval
+	}
+	delay := float64(initial) * math.Pow(mult, float64(attempt-1))
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	if b.Jitter > 0 {
+		delta := b.Jitter * delay
+		delay = delay - delta + rand.Float64()*2*delta
+	}
+	return time.Duration(delay)
+}
+
+// parseRetryAfter interprets the Retry-After header value, which is either a
+// number of seconds or an HTTP-date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	at, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	delay := at.Sub(now)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
+
+// classifyTransportError reports whether err is worth retrying and whether
+// the request is known not to have reached the server.
+func classifyTransportError(err error) (retryable bool, safe bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true, true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.Temporary() || dnsErr.IsTimeout, true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true, true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true, false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true, false
+	}
+	return false, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+type retryAttemptCtxKey struct{}
+
+// RetryAttempt returns the 1-based attempt number of the request carrying
+// ctx, or 0 when the request is not sent through a retrying client.
+func RetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptCtxKey{}).(int)
+	return attempt
+}
+
+// RetryLogger returns a RetryHook printing every retry to w.
+func RetryLogger(w io.Writer) RetryHook {
+	l := log.New(w, "••• ", 0)
+	return func(e RetryEvent) {
+		reason := ""
+		if e.Err != nil {
+			reason = e.Err.Error()
+		} else if e.Response != nil {
+			reason = e.Response.Status
+		}
+		l.Printf("%s %s attempt %d failed (%s), retrying in %s", e.Request.Method, e.Request.URL, e.Attempt, reason, e.Delay)
+	}
+}
+
+// WithRetryPolicy retries failed requests according to policy, calling every
+// hook before each retry.
+func WithRetryPolicy(policy RetryPolicy, hooks ...RetryHook) Option {
+	return func(cbd CBD) (CBD, error) {
+		return &withRetryCBD{cbd, policy, hooks}, nil
+	}
+}
+
+// WithRetry retries failed requests up to limit times using ExponentialBackoff
+// with every delay capped to maxDelay (no cap when zero). Retries are logged
+// to w when it is not nil.
+func WithRetry(limit int, maxDelay time.Duration, w io.Writer) Option {
+	if limit <= 0 {
+		// A zero limit has always meant no retries; keep it that way rather
+		// than falling back to the policy default.
+		return func(cbd CBD) (CBD, error) {
+			return cbd, nil
+		}
+	}
+	var hooks []RetryHook
+	if w != nil {
+		hooks = append(hooks, RetryLogger(w))
+	}
+	return WithRetryPolicy(&ExponentialBackoff{
+		MaxRetries:  limit,
+		MaxInterval: maxDelay,
+		Jitter:      0.2,
+	}, hooks...)
+}
+
+type withRetryCBD struct {
+	CBD
+	policy RetryPolicy
+	hooks  []RetryHook
+}
+
+func (r *withRetryCBD) Do(rq *http.Request) (*http.Response, error) {
+	getBody := rq.GetBody
+	if rq.Body != nil && rq.Body != http.NoBody && getBody == nil {
+		buf, err := io.ReadAll(rq.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = rq.Body.Close()
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(buf)), nil
+		}
+	}
+
+	ctx := rq.Context()
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		try := rq.WithContext(context.WithValue(ctx, retryAttemptCtxKey{}, attempt))
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			try.Body = body
+		}
+
+		rs, err := r.CBD.Do(try)
+
+		delay, retry := r.policy.ShouldRetry(try, rs, err, attempt, time.Since(start))
+		if !retry {
+			return rs, err
+		}
+		event := RetryEvent{Request: try, Response: rs, Err: err, Attempt: attempt, Delay: delay}
+		reportRetry(ctx, event)
+		for i := range r.hooks {
+			r.hooks[i](event)
+		}
+		if rs != nil && rs.Body != nil {
+			_, _ = io.Copy(io.Discard, rs.Body)
+			_ = rs.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
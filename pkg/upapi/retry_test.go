@@ -0,0 +1,201 @@
+package upapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testResponse(code int, header http.Header) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Status:     http.StatusText(code),
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+func TestWithRetryPolicyServerErrors(t *testing.T) {
+	policy := &ExponentialBackoff{InitialInterval: time.Millisecond, MaxRetries: 3}
+
+	t.Run("idempotent request is retried on 503", func(t *testing.T) {
+		cbdm := new(cbdMock)
+		cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusServiceUnavailable, nil), nil).Twice()
+		cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusOK, nil), nil).Once()
+
+		cbd, err := WithRetryPolicy(policy)(cbdm)
+		require.NoError(t, err)
+
+		rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+		rs, err := cbd.Do(rq)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rs.StatusCode)
+		cbdm.AssertExpectations(t)
+	})
+
+	t.Run("create is not replayed on 503", func(t *testing.T) {
+		cbdm := new(cbdMock)
+		cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusServiceUnavailable, nil), nil).Once()
+
+		cbd, err := WithRetryPolicy(policy)(cbdm)
+		require.NoError(t, err)
+
+		rq, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader("{}"))
+		rs, err := cbd.Do(rq)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, rs.StatusCode)
+		cbdm.AssertExpectations(t)
+	})
+
+	t.Run("create is retried when connection was never established", func(t *testing.T) {
+		dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		cbdm := new(cbdMock)
+		cbdm.On("Do", mock.Anything).Return(nil, dialErr).Once()
+		cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusOK, nil), nil).Once()
+
+		cbd, err := WithRetryPolicy(policy)(cbdm)
+		require.NoError(t, err)
+
+		rq, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader("{}"))
+		rs, err := cbd.Do(rq)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rs.StatusCode)
+		cbdm.AssertExpectations(t)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		cbdm := new(cbdMock)
+		cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusBadGateway, nil), nil).Times(4)
+
+		cbd, err := WithRetryPolicy(policy)(cbdm)
+		require.NoError(t, err)
+
+		rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+		rs, err := cbd.Do(rq)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadGateway, rs.StatusCode)
+		cbdm.AssertExpectations(t)
+	})
+}
+
+func TestWithRetryPolicyAttemptsArePerRequest(t *testing.T) {
+	var attempts []int
+	cbdm := new(cbdMock)
+	cbdm.
+		On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			attempts = append(attempts, RetryAttempt(args.Get(0).(*http.Request).Context()))
+		}).
+		Return(testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}}), nil).
+		Times(4)
+
+	cbd, err := WithRetryPolicy(&ExponentialBackoff{MaxRetries: 1})(cbdm)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+		_, err = cbd.Do(rq)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{1, 2, 1, 2}, attempts)
+	cbdm.AssertExpectations(t)
+}
+
+func TestWithRetryPolicyHooks(t *testing.T) {
+	cbdm := new(cbdMock)
+	cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}}), nil).Once()
+	cbdm.On("Do", mock.Anything).Return(testResponse(http.StatusOK, nil), nil).Once()
+
+	var events []RetryEvent
+	cbd, err := WithRetryPolicy(&ExponentialBackoff{}, func(e RetryEvent) {
+		events = append(events, e)
+	})(cbdm)
+	require.NoError(t, err)
+
+	rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+	_, err = cbd.Do(rq)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, 1, events[0].Attempt)
+	require.Equal(t, time.Duration(0), events[0].Delay)
+	require.Equal(t, http.StatusTooManyRequests, events[0].Response.StatusCode)
+}
+
+func TestWithRetryPolicyContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cbdm := new(cbdMock)
+	cbdm.
+		On("Do", mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"60"}}), nil).
+		Once()
+
+	cbd, err := WithRetryPolicy(&ExponentialBackoff{})(cbdm)
+	require.NoError(t, err)
+
+	rq, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	_, err = cbd.Do(rq)
+	require.ErrorIs(t, err, context.Canceled)
+	cbdm.AssertExpectations(t)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	rq, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	t.Run("grows exponentially and is capped", func(t *testing.T) {
+		b := &ExponentialBackoff{InitialInterval: time.Second, MaxInterval: 5 * time.Second, MaxRetries: 10}
+		var delays []time.Duration
+		for attempt := 1; attempt <= 4; attempt++ {
+			delay, ok := b.ShouldRetry(rq, testResponse(http.StatusServiceUnavailable, nil), nil, attempt, 0)
+			require.True(t, ok)
+			delays = append(delays, delay)
+		}
+		require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, delays)
+	})
+
+	t.Run("max elapsed time", func(t *testing.T) {
+		b := &ExponentialBackoff{InitialInterval: time.Second, MaxElapsedTime: 10 * time.Second}
+		_, ok := b.ShouldRetry(rq, testResponse(http.StatusServiceUnavailable, nil), nil, 1, 8*time.Second)
+		require.True(t, ok)
+		_, ok = b.ShouldRetry(rq, testResponse(http.StatusServiceUnavailable, nil), nil, 1, 9500*time.Millisecond)
+		require.False(t, ok)
+	})
+
+	t.Run("client errors are final", func(t *testing.T) {
+		_, ok := (&ExponentialBackoff{}).ShouldRetry(rq, testResponse(http.StatusBadRequest, nil), nil, 1, 0)
+		require.False(t, ok)
+	})
+
+	t.Run("cancellation is final", func(t *testing.T) {
+		_, ok := (&ExponentialBackoff{}).ShouldRetry(rq, nil, context.Canceled, 1, 0)
+		require.False(t, ok)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("120", now)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, d)
+
+	d, ok = parseRetryAfter("Mon, 01 Jan 2024 12:00:30 GMT", now)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, d)
+
+	d, ok = parseRetryAfter("Mon, 01 Jan 2024 11:00:00 GMT", now)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
@@ -0,0 +1,238 @@
+package upapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Step definitions of API and transaction check scripts. Scripts may contain
+// other steps, which are kept as they are.
+const (
+	ScriptOpenURL             = "C_OPEN_URL"
+	ScriptGet                 = "C_GET"
+	ScriptPost                = "C_POST"
+	ScriptPut                 = "C_PUT"
+	ScriptPatch               = "C_PATCH"
+	ScriptDelete              = "C_DELETE"
+	ScriptHead                = "C_HEAD"
+	ScriptOptions             = "C_OPTIONS"
+	ScriptSetVariableFromJSON = "C_SET_VARIABLE_FROM_JSON"
+	ScriptExpectStatusCode    = "V_HTTP_STATUS_CODE_SHOULD_BE"
+	ScriptExpectResponseTime  = "V_RESPONSE_TIME_SHOULD_BE_LESS_THAN"
+	ScriptExpectJSONPath      = "V_JSON_PATH_SHOULD_BE"
+	ScriptExpectBodyContains  = "V_RESPONSE_SHOULD_CONTAIN"
+)
+
+var scriptRequests = map[string]string{
+	http.MethodGet:     ScriptGet,
+	http.MethodPost:    ScriptPost,
+	http.MethodPut:     ScriptPut,
+	http.MethodPatch:   ScriptPatch,
+	http.MethodDelete:  ScriptDelete,
+	http.MethodHead:    ScriptHead,
+	http.MethodOptions: ScriptOptions,
+}
+
+// scriptVariableRef matches references to variables, extracted by earlier
+// steps or defined as service variables, inside step values.
+var scriptVariableRef = regexp.MustCompile(`{{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// Script is the list of steps run by an API or transaction check, stored
+// JSON-encoded in the msp_script field. Values may refer to variables as
+// {{name}}.
+type Script []ScriptStep
+
+// ScriptStep is a single step of a Script. Values are kept as decoded, so
+// steps this package knows nothing about survive a parse and marshal cycle.
+type ScriptStep struct {
+	Def    string         `json:"step_def" yaml:"step_def"`
+	Values map[string]any `json:"values" yaml:"values"`
+}
+
+// ParseScript decodes the msp_script field of a check.
+func ParseScript(s string) (Script, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var script Script
+	if err := json.Unmarshal([]byte(s), &script); err != nil {
+		return nil, fmt.Errorf("invalid script: %w", err)
+	}
+	return script, nil
+}
+
+// MarshalScript encodes script for the msp_script field of a check.
+func MarshalScript(script Script) (string, error) {
+	for i := range script {
+		if script[i].Values == nil {
+			script[i].Values = map[string]any{}
+		}
+	}
+	b, err := json.Marshal(script)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// StepOpenURL opens url in the browser of a transaction check.
+func StepOpenURL(url string) ScriptStep {
+	return ScriptStep{Def: ScriptOpenURL, Values: map[string]any{"url": url}}
+}
+
+// StepRequest sends an HTTP request from an API check. Headers and body may
+// be empty.
+func StepRequest(method, url string, headers map[string]string, body string) ScriptStep {
+	def, ok := scriptRequests[strings.ToUpper(method)]
+	if !ok {
+		def = "C_" + strings.ToUpper(method)
+	}
+	values := map[string]any{"url": url, "headers": map[string]any{}}
+	for k, v := range headers {
+		values["headers"].(map[string]any)[k] = v
+	}
+	if body != "" {
+		values["data"] = body
+	}
+	return ScriptStep{Def: def, Values: values}
+}
+
+// StepExpectStatusCode asserts the status code of the last response.
+func StepExpectStatusCode(code int) ScriptStep {
+	return ScriptStep{Def: ScriptExpectStatusCode, Values: map[string]any{"status_code": strconv.Itoa(code)}}
+}
+
+// StepExpectResponseTime asserts the last response took less than d.
+func StepExpectResponseTime(d time.Duration) ScriptStep {
+	return ScriptStep{Def: ScriptExpectResponseTime, Values: map[string]any{"response_time": strconv.FormatInt(d.Milliseconds(), 10)}}
+}
+
+// StepExpectJSONPath asserts the value found at path in the JSON body of the
+// last response.
+func StepExpectJSONPath(path, value string) ScriptStep {
+	return ScriptStep{Def: ScriptExpectJSONPath, Values: map[string]any{"json_path": path, "value": value}}
+}
+
+// StepExpectBodyContains asserts the body of the last response contains
+// text.
+func StepExpectBodyContains(text string) ScriptStep {
+	return ScriptStep{Def: ScriptExpectBodyContains, Values: map[string]any{"value": text}}
+}
+
+// StepSetVariableFromJSON stores the value found at path in the JSON body of
+// the last response in the variable name, for later steps to use as
+// {{name}}.
+func StepSetVariableFromJSON(name, path string) ScriptStep {
+	return ScriptStep{Def: ScriptSetVariableFromJSON, Values: map[string]any{"variable_name": name, "json_path": path}}
+}
+
+// Validate checks the steps are in a sensible order and every variable they
+// refer to was extracted by an earlier step or is one of serviceVariables.
+// It returns nil or an *Error with messages under the msp_script key.
+func (s Script) Validate(serviceVariables ...string) error {
+	v := newValidation()
+	s.validate(v, serviceVariables, true)
+	return v.err()
+}
+
+// validate adds the problems of the script to v. References to variables are
+// only checked when refs is set, since service variables are not known
+// before the check is created.
+func (s Script) validate(v *validation, serviceVariables []string, refs bool) {
+	const key = "msp_script"
+	if len(s) == 0 {
+		v.add(key, "The script has no steps.")
+		return
+	}
+	defined := make(map[string]bool, len(serviceVariables))
+	for _, name := range serviceVariables {
+		defined[name] = true
+	}
+	requested := false
+	for i, step := range s {
+		n := i + 1
+		switch step.Def {
+		case "":
+			v.add(key, "Step %d: step_def is required.", n)
+			continue
+		case ScriptOpenURL, ScriptGet, ScriptPost, ScriptPut, ScriptPatch, ScriptDelete, ScriptHead, ScriptOptions:
+			if scriptString(step.Values["url"]) == "" {
+				v.add(key, "Step %d: %s requires a url.", n, step.Def)
+			}
+			requested = true
+		case ScriptExpectStatusCode:
+			if code, err := strconv.Atoi(scriptString(step.Values["status_code"])); err != nil || code < 100 || code > 599 {
+				v.add(key, "Step %d: %s requires a status_code between 100 and 599.", n, step.Def)
+			}
+		case ScriptExpectResponseTime:
+			if ms, err := strconv.Atoi(scriptString(step.Values["response_time"])); err != nil || ms <= 0 {
+				v.add(key, "Step %d: %s requires a positive response_time in milliseconds.", n, step.Def)
+			}
+		case ScriptExpectJSONPath:
+			if scriptString(step.Values["json_path"]) == "" {
+				v.add(key, "Step %d: %s requires a json_path.", n, step.Def)
+			}
+		case ScriptSetVariableFromJSON:
+			if scriptString(step.Values["json_path"]) == "" {
+				v.add(key, "Step %d: %s requires a json_path.", n, step.Def)
+			}
+			name := scriptString(step.Values["variable_name"])
+			if name == "" {
+				v.add(key, "Step %d: %s requires a variable_name.", n, step.Def)
+			}
+			defined[name] = true
+		}
+		if i == 0 && !requested {
+			v.add(key, "Step 1: the script must start by opening a URL or sending a request, not with %s.", step.Def)
+		}
+		if refs {
+			for _, name := range scriptRefs(step.Values) {
+				if !defined[name] {
+					v.add(key, "Step %d: variable %q is not defined by an earlier step nor a service variable.", n, name)
+				}
+			}
+		}
+	}
+}
+
+// scriptString returns the string form of a decoded value, accepting numbers
+// since YAML and hand-written JSON often use them for codes and times.
+func scriptString(x any) string {
+	switch x := x.(type) {
+	case string:
+		return x
+	case int:
+		return strconv.Itoa(x)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return ""
+}
+
+// scriptRefs returns the variables referred to by the string values of a
+// step, nested ones included, in order.
+func scriptRefs(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var refs []string
+	for _, k := range keys {
+		switch x := values[k].(type) {
+		case string:
+			for _, m := range scriptVariableRef.FindAllStringSubmatch(x, -1) {
+				refs = append(refs, m[1])
+			}
+		case map[string]any:
+			refs = append(refs, scriptRefs(x)...)
+		}
+	}
+	return refs
+}
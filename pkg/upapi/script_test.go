@@ -0,0 +1,59 @@
+package upapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptRoundTrip(t *testing.T) {
+	script := Script{
+		StepRequest("post", "https://example.com/login", map[string]string{"Content-Type": "application/json"}, `{"user":"{{USER}}"}`),
+		StepExpectStatusCode(200),
+		StepSetVariableFromJSON("token", "$.token"),
+		StepRequest("GET", "https://example.com/me", map[string]string{"Authorization": "Bearer {{ token }}"}, ""),
+		StepExpectJSONPath("$.name", "alice"),
+		StepExpectResponseTime(500 * time.Millisecond),
+		{Def: "C_SOMETHING_NEW", Values: map[string]any{"x": []any{"y"}}},
+	}
+	require.NoError(t, script.Validate("USER"))
+
+	s, err := MarshalScript(script)
+	require.NoError(t, err)
+	require.Contains(t, s, `{"step_def":"C_POST","values":{"data":"{\"user\":\"{{USER}}\"}","headers":{"Content-Type":"application/json"},"url":"https://example.com/login"}}`)
+	require.Contains(t, s, `{"step_def":"V_RESPONSE_TIME_SHOULD_BE_LESS_THAN","values":{"response_time":"500"}}`)
+
+	parsed, err := ParseScript(s)
+	require.NoError(t, err)
+	require.Equal(t, script, parsed)
+
+	_, err = ParseScript(`{"step_def": "C_GET"}`)
+	require.ErrorContains(t, err, "invalid script")
+}
+
+func TestScriptValidate(t *testing.T) {
+	err := Script{
+		StepExpectStatusCode(200),
+		StepRequest("GET", "", nil, ""),
+		{Def: ScriptExpectStatusCode, Values: map[string]any{"status_code": 42}},
+		StepRequest("GET", "https://example.com/{{id}}", map[string]string{"X-Key": "{{API_KEY}}"}, ""),
+		StepSetVariableFromJSON("id", "$.id"),
+	}.Validate()
+	require.True(t, IsValidation(err))
+	require.Equal(t, []any{
+		"Step 1: the script must start by opening a URL or sending a request, not with V_HTTP_STATUS_CODE_SHOULD_BE.",
+		"Step 2: C_GET requires a url.",
+		"Step 3: V_HTTP_STATUS_CODE_SHOULD_BE requires a status_code between 100 and 599.",
+		`Step 4: variable "API_KEY" is not defined by an earlier step nor a service variable.`,
+		`Step 4: variable "id" is not defined by an earlier step nor a service variable.`,
+	}, err.(*Error).Fields["msp_script"])
+
+	require.True(t, IsValidation(Script{}.Validate()))
+
+	// Check validation only looks at the structure, service variables are
+	// not known at that point.
+	require.NoError(t, CheckAPI{Name: "api", Script: `[{"step_def": "C_GET", "values": {"url": "{{BASE}}/health"}}]`}.Validate())
+	err = CheckTransaction{Name: "tx", Script: `not json`}.Validate()
+	require.Equal(t, []string{"msp_script"}, fieldKeys(err.(*Error).Fields))
+}
@@ -0,0 +1,530 @@
+package upapi
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+// TerraformKinds lists the kinds ExportTerraform supports. Status pages come
+// with their components.
+var TerraformKinds = []Kind{KindTag, KindContact, KindIntegration, KindCheck, KindStatusPage}
+
+// TerraformResource is a resource block of the Uptime.com Terraform provider.
+type TerraformResource struct {
+	// Type is the resource type, such as uptime_check_http.
+	Type string
+	// Name is the local name of the block, unique for its type.
+	Name string
+	// ImportID identifies the live resource in an import block.
+	ImportID string
+	// Attributes are written sorted by name. Their values are nil, bool,
+	// float64, string, TerraformExpr, or slices and maps of those.
+	Attributes map[string]any
+	// Omitted lists the fields of the live resource the provider has no
+	// attribute for, such as the escalations of a check. They are left out of
+	// Attributes.
+	Omitted []string
+}
+
+// Address returns the address of r in a Terraform configuration.
+func (r TerraformResource) Address() string {
+	return r.Type + "." + r.Name
+}
+
+// TerraformExpr is an attribute value written as is, such as a reference to
+// another resource.
+type TerraformExpr string
+
+// ExportTerraform returns the Terraform resources describing the resources of
+// the given kinds, or of every kind of TerraformKinds when none is given.
+//
+// Each check maps to the resource type of its check type, such as
+// uptime_check_ssl_cert, and each integration to that of its module, such as
+// uptime_integration_microsoft_teams; checks and integrations the provider
+// has no resource type for make ExportTerraform fail. Fields map to the
+// attributes of the provider through an explicit table per kind, with the SLA
+// of checks in a nested sla attribute; fields missing from it are listed in
+// the Omitted field of their resource. References to checks and status pages
+// are expressed as references to their resources, and server-managed fields
+// and secrets are left out.
+func ExportTerraform(ctx context.Context, api API, kinds ...Kind) ([]TerraformResource, error) {
+	if len(kinds) == 0 {
+		kinds = TerraformKinds
+	}
+	want := make(map[Kind]bool, len(kinds))
+	for _, k := range kinds {
+		if !containsKind(TerraformKinds, k) {
+			return nil, fmt.Errorf("kind %q is not supported by Terraform export", k)
+		}
+		want[k] = true
+	}
+	if want[KindStatusPage] {
+		want[KindStatusPageComponent] = true
+	}
+	st, err := readLive(ctx, api, want)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*liveResource, 0, len(st.resources))
+	for _, r := range st.resources {
+		if want[r.kind] {
+			resources = append(resources, r)
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.kind != b.kind {
+			return kindIndex(a.kind) < kindIndex(b.kind)
+		}
+		return a.name < b.name
+	})
+
+	tf := make([]TerraformResource, len(resources))
+	addresses := make(map[*liveResource]string, len(resources))
+	used := make(map[string]bool)
+	for i, r := range resources {
+		typ, attrs, omitted, err := terraformAttributes(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", r.kind, r.name, err)
+		}
+		res := TerraformResource{Type: typ, ImportID: strconv.FormatInt(int64(r.pk), 10), Attributes: attrs, Omitted: omitted}
+		base := terraformName(r.name, typ)
+		res.Name = base
+		for n := 2; used[res.Address()]; n++ {
+			res.Name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[res.Address()] = true
+		addresses[r] = res.Address()
+		tf[i] = res
+	}
+	// References point at the resource blocks, or at the primary keys of the
+	// resources left out.
+	ref := func(kind Kind, v any) any {
+		pk, ok := asPK(v)
+		if !ok {
+			return v
+		}
+		for r, addr := range addresses {
+			if r.kind == kind && r.pk == pk {
+				return TerraformExpr(addr + ".id")
+			}
+		}
+		return v
+	}
+	for i, r := range resources {
+		if r.kind != KindStatusPageComponent {
+			continue
+		}
+		attrs := tf[i].Attributes
+		tf[i].ImportID = fmt.Sprintf("%d:%d", r.parent.pk, r.pk)
+		attrs["statuspage_id"] = ref(KindStatusPage, float64(r.parent.pk))
+		for field, kind := range map[string]Kind{"service_id": KindCheck, "group_id": KindStatusPageComponent} {
+			if v, ok := attrs[field]; ok {
+				attrs[field] = ref(kind, v)
+			}
+		}
+	}
+	return tf, nil
+}
+
+// terraformCheckTypes maps check types to the resource types of the provider.
+var terraformCheckTypes = map[string]string{
+	"API":         "uptime_check_api",
+	"BLACKLIST":   "uptime_check_blacklist",
+	"DNS":         "uptime_check_dns",
+	"GROUP":       "uptime_check_group",
+	"HEARTBEAT":   "uptime_check_heartbeat",
+	"HTTP":        "uptime_check_http",
+	"ICMP":        "uptime_check_icmp",
+	"IMAP":        "uptime_check_imap",
+	"MALWARE":     "uptime_check_malware",
+	"NTP":         "uptime_check_ntp",
+	"PAGESPEED":   "uptime_check_pagespeed",
+	"POP":         "uptime_check_pop",
+	"RDAP":        "uptime_check_rdap",
+	"RUM":         "uptime_check_rum",
+	"RUM2":        "uptime_check_rum2",
+	"SMTP":        "uptime_check_smtp",
+	"SSH":         "uptime_check_ssh",
+	"SSL_CERT":    "uptime_check_ssl_cert",
+	"TCP":         "uptime_check_tcp",
+	"TRANSACTION": "uptime_check_transaction",
+	"UDP":         "uptime_check_udp",
+	"WEBHOOK":     "uptime_check_webhook",
+	"WHOIS":       "uptime_check_whois",
+}
+
+// terraformIntegrationTypes maps integration modules, keyed like
+// integrationModules, to the resource types of the provider.
+var terraformIntegrationTypes = map[string]string{
+	"cachet":          "uptime_integration_cachet",
+	"datadog":         "uptime_integration_datadog",
+	"geckoboard":      "uptime_integration_geckoboard",
+	"jiraservicedesk": "uptime_integration_jira_servicedesk",
+	"klipfolio":       "uptime_integration_klipfolio",
+	"librato":         "uptime_integration_librato",
+	"microsoftteams":  "uptime_integration_microsoft_teams",
+	"opsgenie":        "uptime_integration_opsgenie",
+	"pagerduty":       "uptime_integration_pagerduty",
+	"pushbullet":      "uptime_integration_pushbullet",
+	"pushover":        "uptime_integration_pushover",
+	"slack":           "uptime_integration_slack",
+	"status":          "uptime_integration_status",
+	"statuspage":      "uptime_integration_statuspage",
+	"twitter":         "uptime_integration_twitter",
+	"victorops":       "uptime_integration_victorops",
+	"wavefront":       "uptime_integration_wavefront",
+	"webhook":         "uptime_integration_webhook",
+	"zapier":          "uptime_integration_zapier",
+}
+
+// terraformAttribute is the provider attribute an API field maps to. Path
+// names nested attributes with dots, and convert turns the API value into
+// that of the attribute.
+type terraformAttribute struct {
+	path    string
+	convert func(any) (any, error)
+}
+
+// terraformSameNames maps each of fields to the attribute of the same name.
+func terraformSameNames(fields ...string) map[string]terraformAttribute {
+	m := make(map[string]terraformAttribute, len(fields))
+	for _, f := range fields {
+		m[f] = terraformAttribute{path: f}
+	}
+	return m
+}
+
+// terraformKindAttributes maps the fields of tags, contacts, status pages and
+// their components to the attributes of the provider.
+var terraformKindAttributes = map[Kind]map[string]terraformAttribute{
+	KindTag:     terraformSameNames("tag", "color_hex"),
+	KindContact: terraformSameNames("name", "sms_list", "email_list", "phonecall_list", "integrations", "push_notification_profiles"),
+	KindStatusPage: terraformSameNames(
+		"name", "visibility_level", "description", "page_type", "slug", "cname", "allow_subscriptions",
+		"allow_search_indexing", "allow_drill_down", "auth_username", "auth_password", "max_visible_component_days",
+		"show_status_tab", "show_active_incidents", "show_component_response_time", "show_history_tab",
+		"default_history_date_range", "uptime_calculation_type", "show_history_snake", "show_component_history",
+		"show_summary_metrics", "show_past_incidents", "allow_pdf_report", "google_analytics_code", "contact_email",
+		"email_from", "email_reply_to", "custom_header_html", "custom_footer_html", "custom_css",
+		"company_website_url", "timezone", "allow_subscriptions_email", "allow_subscriptions_rss",
+		"allow_subscriptions_slack", "allow_subscriptions_sms", "allow_subscriptions_webhook",
+		"hide_empty_tabs_history", "theme", "custom_header_bg_color_hex", "custom_header_text_color_hex",
+	),
+	KindStatusPageComponent: terraformSameNames(
+		"name", "description", "is_group", "group_id", "service_id", "status", "auto_status_down", "auto_status_up",
+	),
+}
+
+// terraformCheckAttributes maps the fields of the check request structs to
+// the attributes of the check resources of the provider, which only differ in
+// the fields they have. Fields missing from it, such as the sslconfig of SSL
+// certificate checks, have no attribute of the same shape in the provider.
+var terraformCheckAttributes = map[string]terraformAttribute{
+	"name":                          {path: "name"},
+	"contact_groups":                {path: "contact_groups"},
+	"locations":                     {path: "locations"},
+	"tags":                          {path: "tags"},
+	"is_paused":                     {path: "is_paused"},
+	"msp_address":                   {path: "address"},
+	"msp_port":                      {path: "port"},
+	"msp_interval":                  {path: "interval"},
+	"msp_threshold":                 {path: "threshold"},
+	"msp_sensitivity":               {path: "sensitivity"},
+	"msp_num_retries":               {path: "num_retries"},
+	"msp_notes":                     {path: "notes"},
+	"msp_include_in_global_metrics": {path: "include_in_global_metrics"},
+	"msp_username":                  {path: "username"},
+	"msp_password":                  {path: "password"},
+	"msp_proxy":                     {path: "proxy"},
+	"msp_status_code":               {path: "status_code"},
+	"msp_send_string":               {path: "send_string"},
+	"msp_expect_string":             {path: "expect_string"},
+	"msp_expect_string_type":        {path: "expect_string_type"},
+	"msp_encryption":                {path: "encryption"},
+	"msp_version":                   {path: "version"},
+	"msp_script":                    {path: "script"},
+	"msp_protocol":                  {path: "protocol"},
+	"msp_dns_server":                {path: "dns_server"},
+	"msp_dns_record_type":           {path: "dns_record_type"},
+	"msp_headers":                   {path: "headers", convert: terraformHeaders},
+	"msp_uptime_sla":                {path: "sla.uptime", convert: terraformNumber},
+	"msp_response_time_sla":         {path: "sla.latency", convert: terraformSeconds},
+}
+
+// terraformAttributes returns the resource type and the attributes of r, and
+// the fields of r the provider has no attribute for.
+func terraformAttributes(r *liveResource) (string, map[string]any, []string, error) {
+	raw := stripServerFields(copyMap(r.raw))
+	secrets := kindSecrets(r.kind)
+	schema := terraformKindAttributes[r.kind]
+	var typ string
+	var omitted []string
+	switch r.kind {
+	case KindTag:
+		typ = "uptime_tag"
+	case KindContact:
+		typ = "uptime_contact"
+	case KindStatusPage:
+		typ = "uptime_statuspage"
+	case KindStatusPageComponent:
+		typ = "uptime_statuspage_component"
+	case KindCheck:
+		var c Check
+		if err := fromMap(r.raw, &c); err != nil {
+			return "", nil, nil, err
+		}
+		req, err := c.ToRequest()
+		if err != nil {
+			return "", nil, nil, err
+		}
+		if raw, err = toMap(req); err != nil {
+			return "", nil, nil, err
+		}
+		secrets = sensitiveKeysOf(reflect.TypeOf(req))
+		schema = terraformCheckAttributes
+		var ok bool
+		if typ, ok = terraformCheckTypes[c.CheckType]; !ok {
+			return "", nil, nil, fmt.Errorf("check type %q has no Terraform resource type", c.CheckType)
+		}
+		if len(c.Escalations) > 0 {
+			omitted = append(omitted, "escalations")
+		}
+	case KindIntegration:
+		m, err := integrationModuleOf(raw["module"])
+		if err != nil {
+			return "", nil, nil, err
+		}
+		if raw, err = m.fields(raw); err != nil {
+			return "", nil, nil, err
+		}
+		module, _ := r.raw["module"].(string)
+		var ok bool
+		if typ, ok = terraformIntegrationTypes[integrationModuleReplacer.Replace(strings.ToLower(module))]; !ok {
+			return "", nil, nil, fmt.Errorf("integration module %q has no Terraform resource type", module)
+		}
+		// The integration resources of the provider have the fields of the
+		// request struct of their module.
+		schema = terraformSameNames(sortedKeys(raw)...)
+	default:
+		return "", nil, nil, fmt.Errorf("not supported by Terraform export")
+	}
+	for _, f := range manifestKinds[r.kind].serverFields {
+		delete(raw, f)
+	}
+	raw = dropEmpty(raw)
+	attrs := make(map[string]any, len(raw))
+	for _, k := range sortedKeys(raw) {
+		if secrets[k] {
+			continue
+		}
+		a, ok := schema[k]
+		if !ok {
+			omitted = append(omitted, k)
+			continue
+		}
+		v := raw[k]
+		if a.convert != nil {
+			var err error
+			if v, err = a.convert(v); err != nil {
+				return "", nil, nil, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		m := attrs
+		path := strings.Split(a.path, ".")
+		for _, p := range path[:len(path)-1] {
+			sub, _ := m[p].(map[string]any)
+			if sub == nil {
+				sub = make(map[string]any)
+				m[p] = sub
+			}
+			m = sub
+		}
+		m[path[len(path)-1]] = v
+	}
+	sort.Strings(omitted)
+	return typ, attrs, omitted, nil
+}
+
+// terraformNumber turns a decimal, which the API sends as a string, into a
+// number.
+func terraformNumber(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// terraformSeconds turns a number of seconds into a duration such as "1.5s".
+func terraformSeconds(v any) (any, error) {
+	d, err := decimal.NewFromString(fmt.Sprint(v))
+	if err != nil {
+		return nil, err
+	}
+	return time.Duration(d.Shift(9).IntPart()).String(), nil
+}
+
+// terraformHeaders turns the headers of a check, one "Name: value" per line,
+// into a map of the values of each header.
+func terraformHeaders(v any) (any, error) {
+	s, _ := v.(string)
+	headers := make(map[string]any)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid header %q", line)
+		}
+		name = strings.TrimSpace(name)
+		values, _ := headers[name].([]any)
+		headers[name] = append(values, strings.TrimSpace(value))
+	}
+	return headers, nil
+}
+
+// terraformName turns name into a Terraform identifier, falling back to the
+// last part of typ when nothing is left of it.
+func terraformName(name, typ string) string {
+	s := strings.ReplaceAll(slug(name), "-", "_")
+	if s == "" {
+		s = typ[strings.LastIndex(typ, "_")+1:]
+	}
+	if !unicode.IsLetter(rune(s[0])) {
+		s = "_" + s
+	}
+	return s
+}
+
+// EncodeTerraform writes the resource blocks of resources to w, followed by an
+// import block for each of them. The fields a resource omits are named in a
+// comment of its block.
+func EncodeTerraform(w io.Writer, resources ...TerraformResource) error {
+	bw := bufio.NewWriter(w)
+	for _, r := range resources {
+		fmt.Fprintf(bw, "resource %q %q {\n", r.Type, r.Name)
+		if len(r.Omitted) > 0 {
+			fmt.Fprintf(bw, "  # Not exported, no provider attribute: %s\n", strings.Join(r.Omitted, ", "))
+		}
+		writeHCLAttributes(bw, r.Attributes, 1)
+		bw.WriteString("}\n\n")
+	}
+	for _, r := range resources {
+		fmt.Fprintf(bw, "import {\n  to = %s\n  id = %s\n}\n\n", r.Address(), hclString(r.ImportID))
+	}
+	return bw.Flush()
+}
+
+func writeHCLAttributes(w *bufio.Writer, attrs map[string]any, depth int) {
+	keys := sortedKeys(attrs)
+	width := 0
+	for _, k := range keys {
+		if n := len(hclKey(k)); n > width {
+			width = n
+		}
+	}
+	indent := strings.Repeat("  ", depth)
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s%-*s = ", indent, width, hclKey(k))
+		writeHCLValue(w, attrs[k], depth)
+		w.WriteByte('\n')
+	}
+}
+
+func writeHCLValue(w *bufio.Writer, v any, depth int) {
+	switch v := v.(type) {
+	case nil:
+		w.WriteString("null")
+	case bool:
+		w.WriteString(strconv.FormatBool(v))
+	case float64:
+		w.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		w.WriteString(hclString(v))
+	case TerraformExpr:
+		w.WriteString(string(v))
+	case []any:
+		w.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			writeHCLValue(w, item, depth)
+		}
+		w.WriteByte(']')
+	case map[string]any:
+		if len(v) == 0 {
+			w.WriteString("{}")
+			return
+		}
+		w.WriteString("{\n")
+		writeHCLAttributes(w, v, depth+1)
+		w.WriteString(strings.Repeat("  ", depth) + "}")
+	default:
+		w.WriteString(hclString(fmt.Sprint(v)))
+	}
+}
+
+// hclKey returns k, quoted unless it is a valid identifier.
+func hclKey(k string) string {
+	for i, r := range k {
+		if !(unicode.IsLetter(r) || r == '_' || i > 0 && (unicode.IsDigit(r) || r == '-')) {
+			return hclString(k)
+		}
+	}
+	if k == "" {
+		return `""`
+	}
+	return k
+}
+
+// hclString returns s as an HCL quoted string, with template sequences
+// escaped.
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func containsKind(kinds []Kind, k Kind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+package upapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformNames(t *testing.T) {
+	require.Equal(t, "_1_api", terraformName("1 API", "uptime_check_http"))
+	require.Equal(t, "http", terraformName("!!", "uptime_check_http"))
+	require.Equal(t, `"a b"`, hclKey("a b"))
+	require.Equal(t, "x-y", hclKey("x-y"))
+	require.Equal(t, `"say \"hi\"\n%%{x}"`, hclString("say \"hi\"\n%{x}"))
+}
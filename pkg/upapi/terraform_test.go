@@ -0,0 +1,243 @@
+package upapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestExportTerraform(t *testing.T) {
+	_, api := newTestAccount(t, map[string]string{
+		"check-tags":   `[{"pk": 1, "url": "https://uptime.com/api/v1/check-tags/1/", "tag": "prod", "color_hex": "#ff0000"}]`,
+		"integrations": `[{"pk": 3, "name": "Ops Slack", "module": "slack", "contact_groups": ["Ops"], "is_errored": true}]`,
+		"checks": `[{"pk": 10, "name": "www.example.com", "check_type": "SSL_CERT", "msp_address": "example.com",
+			"msp_uptime_sla": "0.9990", "msp_notes": "Renew via ${tool}", "contact_groups": ["Ops"], "state_is_up": true,
+			"escalations": [{"wait_time": 10, "num_repeats": 1, "contact_groups": ["Ops"]}]}]`,
+		"statuspages": `[{"pk": 70, "name": "Public", "slug": "public"}]`,
+		"statuspages/70/components": `[{"pk": 71, "name": "API", "service_id": 10, "group_id": 72},
+			{"pk": 72, "name": "Services", "is_group": true}]`,
+	})
+
+	resources, err := upapi.ExportTerraform(context.Background(), api, upapi.KindTag, upapi.KindIntegration, upapi.KindCheck, upapi.KindStatusPage)
+	require.NoError(t, err)
+	for i, r := range resources {
+		if r.Type == "uptime_statuspage" {
+			// Only keep the attributes the fixture sets.
+			resources[i].Attributes = map[string]any{"name": r.Attributes["name"], "slug": r.Attributes["slug"]}
+		}
+	}
+	var b bytes.Buffer
+	require.NoError(t, upapi.EncodeTerraform(&b, resources...))
+	require.Equal(t, `resource "uptime_tag" "prod" {
+  color_hex = "#ff0000"
+  tag       = "prod"
+}
+
+resource "uptime_integration_slack" "ops_slack" {
+  contact_groups = ["Ops"]
+  name           = "Ops Slack"
+}
+
+resource "uptime_check_ssl_cert" "www_example_com" {
+  # Not exported, no provider attribute: escalations
+  address        = "example.com"
+  contact_groups = ["Ops"]
+  is_paused      = false
+  name           = "www.example.com"
+  notes          = "Renew via $${tool}"
+  sla            = {
+    uptime = 0.999
+  }
+}
+
+resource "uptime_statuspage" "public" {
+  name = "Public"
+  slug = "public"
+}
+
+resource "uptime_statuspage_component" "api" {
+  group_id      = uptime_statuspage_component.services.id
+  name          = "API"
+  service_id    = uptime_check_ssl_cert.www_example_com.id
+  statuspage_id = uptime_statuspage.public.id
+}
+
+resource "uptime_statuspage_component" "services" {
+  is_group      = true
+  name          = "Services"
+  statuspage_id = uptime_statuspage.public.id
+}
+
+import {
+  to = uptime_tag.prod
+  id = "1"
+}
+
+import {
+  to = uptime_integration_slack.ops_slack
+  id = "3"
+}
+
+import {
+  to = uptime_check_ssl_cert.www_example_com
+  id = "10"
+}
+
+import {
+  to = uptime_statuspage.public
+  id = "70"
+}
+
+import {
+  to = uptime_statuspage_component.api
+  id = "70:71"
+}
+
+import {
+  to = uptime_statuspage_component.services
+  id = "70:72"
+}
+
+`, b.String())
+
+	_, err = upapi.ExportTerraform(context.Background(), api, upapi.KindDashboard)
+	require.ErrorContains(t, err, `kind "Dashboard" is not supported by Terraform export`)
+}
+
+func TestExportTerraformResourceTypes(t *testing.T) {
+	types := map[string]string{
+		"API":         "uptime_check_api",
+		"BLACKLIST":   "uptime_check_blacklist",
+		"DNS":         "uptime_check_dns",
+		"GROUP":       "uptime_check_group",
+		"HEARTBEAT":   "uptime_check_heartbeat",
+		"HTTP":        "uptime_check_http",
+		"ICMP":        "uptime_check_icmp",
+		"IMAP":        "uptime_check_imap",
+		"MALWARE":     "uptime_check_malware",
+		"NTP":         "uptime_check_ntp",
+		"PAGESPEED":   "uptime_check_pagespeed",
+		"POP":         "uptime_check_pop",
+		"RDAP":        "uptime_check_rdap",
+		"RUM":         "uptime_check_rum",
+		"RUM2":        "uptime_check_rum2",
+		"SMTP":        "uptime_check_smtp",
+		"SSH":         "uptime_check_ssh",
+		"SSL_CERT":    "uptime_check_ssl_cert",
+		"TCP":         "uptime_check_tcp",
+		"TRANSACTION": "uptime_check_transaction",
+		"UDP":         "uptime_check_udp",
+		"WEBHOOK":     "uptime_check_webhook",
+		"WHOIS":       "uptime_check_whois",
+	}
+	var checks []map[string]any
+	for checkType := range types {
+		checks = append(checks, map[string]any{"pk": len(checks) + 1, "name": checkType, "check_type": checkType})
+	}
+	b, err := json.Marshal(checks)
+	require.NoError(t, err)
+	fake, api := newTestAccount(t, map[string]string{
+		"checks": string(b),
+		"integrations": `[{"pk": 1, "name": "Jira", "module": "jira_servicedesk"},
+			{"pk": 2, "name": "Teams", "module": "microsoft-teams"}]`,
+	})
+
+	resources, err := upapi.ExportTerraform(context.Background(), api, upapi.KindIntegration, upapi.KindCheck)
+	require.NoError(t, err)
+	got := make(map[string]string, len(resources))
+	for _, r := range resources {
+		got[r.Attributes["name"].(string)] = r.Type
+	}
+	types["Jira"] = "uptime_integration_jira_servicedesk"
+	types["Teams"] = "uptime_integration_microsoft_teams"
+	require.Equal(t, types, got)
+
+	fake.Add("checks", map[string]any{"pk": 100, "name": "status", "check_type": "CLOUDSTATUS"})
+	_, err = upapi.ExportTerraform(context.Background(), api, upapi.KindCheck)
+	require.ErrorContains(t, err, `check type "CLOUDSTATUS" has no Terraform resource type`)
+}
+
+func TestExportTerraformAttributes(t *testing.T) {
+	_, api := newTestAccount(t, map[string]string{
+		"checks": `[{"pk": 1, "name": "www", "check_type": "HTTP", "msp_address": "https://example.com", "msp_port": 443,
+			"msp_interval": 5, "msp_threshold": 20, "msp_sensitivity": 2, "msp_num_retries": 2, "msp_status_code": "200",
+			"msp_expect_string": "ok", "msp_headers": "Accept: text/html\nX-Trace: a\nX-Trace: b",
+			"msp_uptime_sla": "0.9990", "msp_response_time_sla": "1.500", "msp_use_ip_version": "IPV4",
+			"locations": ["US-East", "GBR"], "tags": ["prod"], "contact_groups": ["Ops"],
+			"escalations": [{"wait_time": 10, "num_repeats": 1, "contact_groups": ["Ops"]}]}]`,
+	})
+
+	resources, err := upapi.ExportTerraform(context.Background(), api, upapi.KindCheck)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	require.Equal(t, map[string]any{
+		"name":           "www",
+		"address":        "https://example.com",
+		"port":           float64(443),
+		"interval":       float64(5),
+		"threshold":      float64(20),
+		"sensitivity":    float64(2),
+		"num_retries":    float64(2),
+		"status_code":    "200",
+		"expect_string":  "ok",
+		"headers":        map[string]any{"Accept": []any{"text/html"}, "X-Trace": []any{"a", "b"}},
+		"sla":            map[string]any{"uptime": 0.999, "latency": "1.5s"},
+		"locations":      []any{"US-East", "GBR"},
+		"tags":           []any{"prod"},
+		"contact_groups": []any{"Ops"},
+		"is_paused":      false,
+
+		"include_in_global_metrics": false,
+	}, resources[0].Attributes)
+	require.Equal(t, []string{"escalations", "msp_use_ip_version"}, resources[0].Omitted)
+}
+
+// providerCheckAttributes are the attributes of the check resources of the
+// Uptime.com provider.
+var providerCheckAttributes = map[string]bool{
+	"name": true, "contact_groups": true, "locations": true, "tags": true, "is_paused": true, "address": true,
+	"port": true, "interval": true, "threshold": true, "sensitivity": true, "num_retries": true, "notes": true,
+	"include_in_global_metrics": true, "username": true, "password": true, "proxy": true, "status_code": true,
+	"send_string": true, "expect_string": true, "expect_string_type": true, "encryption": true, "version": true,
+	"script": true, "protocol": true, "dns_server": true, "dns_record_type": true, "headers": true, "sla": true,
+}
+
+func TestExportTerraformCheckAttributeNames(t *testing.T) {
+	var checks []map[string]any
+	for i, checkType := range []string{"API", "BLACKLIST", "DNS", "GROUP", "HEARTBEAT", "HTTP", "ICMP", "IMAP",
+		"MALWARE", "NTP", "PAGESPEED", "POP", "RDAP", "RUM", "RUM2", "SMTP", "SSH", "SSL_CERT", "TCP",
+		"TRANSACTION", "UDP", "WEBHOOK", "WHOIS"} {
+		checks = append(checks, map[string]any{
+			"pk": i + 1, "name": checkType, "check_type": checkType, "contact_groups": []string{"Ops"},
+			"locations": []string{"US-East"}, "tags": []string{"prod"}, "is_paused": true, "msp_address": "example.com",
+			"msp_port": 443, "msp_interval": 5, "msp_threshold": 20, "msp_sensitivity": 2, "msp_num_retries": 2,
+			"msp_notes": "notes", "msp_include_in_global_metrics": true, "msp_username": "user", "msp_proxy": "proxy",
+			"msp_status_code": "200", "msp_send_string": "ping", "msp_expect_string": "pong",
+			"msp_expect_string_type": "STRING", "msp_encryption": "SSL_TLS", "msp_version": 2, "msp_script": "[]",
+			"msp_protocol": "https", "msp_dns_server": "8.8.8.8", "msp_dns_record_type": "A", "msp_headers": "A: b",
+			"msp_uptime_sla": "0.999", "msp_response_time_sla": "1.5",
+		})
+	}
+	b, err := json.Marshal(checks)
+	require.NoError(t, err)
+	_, api := newTestAccount(t, map[string]string{"checks": string(b)})
+
+	resources, err := upapi.ExportTerraform(context.Background(), api, upapi.KindCheck)
+	require.NoError(t, err)
+	require.Len(t, resources, len(checks))
+	for _, r := range resources {
+		for name, v := range r.Attributes {
+			require.True(t, providerCheckAttributes[name], "%s: %s", r.Type, name)
+			if name == "sla" {
+				for k := range v.(map[string]any) {
+					require.Contains(t, []string{"latency", "uptime"}, k, r.Type)
+				}
+			}
+		}
+	}
+}
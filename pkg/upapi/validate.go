@@ -0,0 +1,492 @@
+package upapi
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Validator is implemented by request types which can be checked before they
+// are sent. Validate returns nil or an *Error shaped like the server's
+// validation errors, so IsValidation, Error.Fields and Error.FieldErrors work
+// the same for both.
+//
+// Validate checks a complete request, as sent on creation. Partial requests
+// used for PATCH updates may legitimately fail it.
+type Validator interface {
+	Validate() error
+}
+
+var (
+	checkIntervals    = []int64{1, 2, 3, 5, 10, 15, 30, 60}
+	expectStringTypes = []string{"STRING", "REGEX", "INVERSE_REGEX"}
+	ipVersions        = []string{"IPV4", "IPV6"}
+	dnsRecordTypes    = []string{
+		"ANY", "A", "AAAA", "CAA", "CNAME", "DNSKEY", "DS", "MX", "NS", "NSEC", "NSEC3", "PTR", "RRSIG", "SOA", "SRV", "TXT",
+	}
+	cloudStatusMonitoringTypes = []string{"ALL", "SPECIFIC"}
+	maintenanceStates          = []string{"ACTIVE", "SCHEDULED", "SUSPENDED"}
+	maintenanceScheduleTypes   = []string{"ONCE", "DAILY", "WEEKLY", "MONTHLY"}
+	incidentTypes              = []string{"INCIDENT", "MAINTENANCE"}
+	statusPageVisibilityLevels = []string{"PUBLIC", "UPTIME_USERS", "EXTERNAL_USERS"}
+	statusPageTypes            = []string{"INTERNAL", "PUBLIC", "PUBLIC_SLA"}
+	slaReportDateRanges        = []string{
+		"TODAY", "YESTERDAY", "THIS_WEEK", "LAST_WEEK", "LAST_7_DAYS", "THIS_MONTH", "LAST_MONTH", "LAST_30_DAYS",
+		"THIS_QUARTER", "LAST_QUARTER", "THIS_YEAR", "LAST_YEAR", "LAST_12_MONTHS",
+	}
+)
+
+// validation collects field errors keyed the way the server reports them.
+type validation struct {
+	fields FieldErrors
+}
+
+func newValidation() *validation {
+	return &validation{fields: make(FieldErrors)}
+}
+
+func (v *validation) add(key, format string, args ...any) {
+	v.fields[key] = append(v.fields[key], fmt.Sprintf(format, args...))
+}
+
+func (v *validation) required(key string, value any) {
+	if value == nil || reflect.ValueOf(value).IsZero() {
+		v.add(key, "This field is required.")
+	}
+}
+
+func (v *validation) choice(key, value string, allowed []string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.add(key, "%q is not a valid choice.", value)
+}
+
+func (v *validation) between(key string, value, min, max int64) {
+	if value < min {
+		v.add(key, "Ensure this value is greater than or equal to %d.", min)
+	} else if value > max {
+		v.add(key, "Ensure this value is less than or equal to %d.", max)
+	}
+}
+
+func (v *validation) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &Error{
+		Code:    "VALIDATION_ERROR",
+		Message: "One or more fields failed validation.",
+		Fields:  v.fields,
+	}
+}
+
+// validateCheck applies the rules shared by every check type to the fields
+// of rq, identified by their JSON key, and requires the given keys on top of
+// the name.
+func validateCheck(rq CheckRequest, required ...string) *validation {
+	v := newValidation()
+	v.required("name", rq.CheckName())
+	values := make(map[string]reflect.Value)
+	collectJSONFields(values, "", reflect.ValueOf(rq))
+	for _, key := range required {
+		if f, ok := values[key]; !ok || f.IsZero() {
+			v.add(key, "This field is required.")
+		}
+	}
+	for key, f := range values {
+		if f.Kind() == reflect.Pointer {
+			if f.IsNil() {
+				continue
+			}
+			f = f.Elem()
+		}
+		switch key {
+		case "msp_interval":
+			if n := f.Int(); n != 0 && !containsInt(checkIntervals, n) {
+				v.add(key, "%d is not a valid choice.", n)
+			}
+		case "msp_port":
+			if n := f.Int(); n != 0 {
+				v.between(key, n, 1, 65535)
+			}
+		case "msp_sensitivity":
+			if n := f.Int(); n != 0 {
+				v.between(key, n, 1, 5)
+			}
+		case "msp_num_retries":
+			if n := f.Int(); n != 0 {
+				v.between(key, n, 1, 5)
+			}
+		case "msp_use_ip_version":
+			v.choice(key, f.String(), ipVersions)
+		case "msp_expect_string_type":
+			v.choice(key, f.String(), expectStringTypes)
+		case "msp_dns_record_type":
+			v.choice(key, f.String(), dnsRecordTypes)
+		case "cloudstatusconfig.monitoring_type":
+			v.choice(key, f.String(), cloudStatusMonitoringTypes)
+		case "msp_uptime_sla":
+			d := f.Interface().(decimal.Decimal)
+			if d.IsNegative() || d.GreaterThan(decimal.NewFromInt(1)) {
+				v.add(key, "Ensure this value is between 0 and 1.")
+			}
+		case "msp_response_time_sla":
+			if f.Interface().(decimal.Decimal).IsNegative() {
+				v.add(key, "Ensure this value is greater than or equal to 0.")
+			}
+		}
+	}
+	return v
+}
+
+// collectJSONFields records the exported fields of the struct rv under their
+// dotted JSON key, descending into nested structs.
+func collectJSONFields(dst map[string]reflect.Value, prefix string, rv reflect.Value) {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != reflect.TypeOf(decimal.Decimal{}) && ft != reflect.TypeOf(CloudStatusGroup{}) {
+			collectJSONFields(dst, key+".", rv.Field(i))
+			continue
+		}
+		dst[key] = rv.Field(i)
+	}
+}
+
+// validateScript checks the structure of a msp_script value, if any.
+func validateScript(v *validation, s string) {
+	if s == "" {
+		return
+	}
+	script, err := ParseScript(s)
+	if err != nil {
+		v.add("msp_script", "%s", err)
+		return
+	}
+	script.validate(v, nil, false)
+}
+
+func containsInt(list []int64, n int64) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CheckAPI) Validate() error {
+	v := validateCheck(c, "msp_script")
+	validateScript(v, c.Script)
+	return v.err()
+}
+
+func (c CheckBlacklist) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckCloudStatus) Validate() error {
+	v := validateCheck(c)
+	cfg := c.CloudStatusConfig
+	if cfg.ServiceName == "" && (cfg.Group == nil || cfg.Group.ID == 0) {
+		v.add("cloudstatusconfig.service_name", "Either service_name or group is required.")
+	}
+	if cfg.MonitoringType == "SPECIFIC" && len(cfg.Services) == 0 && len(cfg.ServiceTitles) == 0 {
+		v.add("cloudstatusconfig.services", "Either services or service_titles is required when monitoring_type is SPECIFIC.")
+	}
+	return v.err()
+}
+
+func (c CheckDNS) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckGroup) Validate() error {
+	return validateCheck(c).err()
+}
+
+func (c CheckHeartbeat) Validate() error {
+	return validateCheck(c).err()
+}
+
+func (c CheckHTTP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckICMP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckIMAP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckMalware) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckNTP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckPageSpeed) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckPOP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckRDAP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckRUM) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckRUM2) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckSMTP) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckSSH) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckSSLCert) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+func (c CheckTCP) Validate() error {
+	return validateCheck(c, "msp_address", "msp_port").err()
+}
+
+func (c CheckTransaction) Validate() error {
+	v := validateCheck(c, "msp_script")
+	validateScript(v, c.Script)
+	return v.err()
+}
+
+func (c CheckUDP) Validate() error {
+	return validateCheck(c, "msp_address", "msp_port").err()
+}
+
+func (c CheckWebhook) Validate() error {
+	return validateCheck(c).err()
+}
+
+func (c CheckWHOIS) Validate() error {
+	return validateCheck(c, "msp_address").err()
+}
+
+// Validate checks the maintenance state and that every schedule entry
+// carries the fields its type needs.
+func (m CheckMaintenance) Validate() error {
+	v := newValidation()
+	v.choice("state", m.State, maintenanceStates)
+	if m.State == "SCHEDULED" && len(m.Schedule) == 0 {
+		v.add("schedule", "At least one schedule is required when state is SCHEDULED.")
+	}
+	for i, s := range m.Schedule {
+		validateMaintenanceSchedule(v, fmt.Sprintf("schedule.%d.", i), s)
+	}
+	return v.err()
+}
+
+func validateMaintenanceSchedule(v *validation, prefix string, s CheckMaintenanceSchedule) {
+	v.required(prefix+"type", s.Type)
+	v.choice(prefix+"type", s.Type, maintenanceScheduleTypes)
+	if s.Type == "ONCE" {
+		start, startOK := parseDateTime(v, prefix+"once_start_date", s.OnceStartDate)
+		end, endOK := parseDateTime(v, prefix+"once_end_date", s.OnceEndDate)
+		if startOK && endOK && !end.After(start) {
+			v.add(prefix+"once_end_date", "End date must be after start date.")
+		}
+		return
+	}
+	parseClock(v, prefix+"from_time", s.FromTime)
+	parseClock(v, prefix+"to_time", s.ToTime)
+	switch s.Type {
+	case "WEEKLY":
+		if len(s.Weekdays) == 0 {
+			v.add(prefix+"weekdays", "This field is required.")
+		}
+		for _, d := range s.Weekdays {
+			if d < 0 || d > 6 {
+				v.add(prefix+"weekdays", "%d is not a valid weekday.", d)
+			}
+		}
+	case "MONTHLY":
+		switch {
+		case s.Monthday != 0:
+			v.between(prefix+"monthday", int64(s.Monthday), 1, 31)
+		case s.MonthdayFrom != 0 || s.MonthdayTo != 0:
+			v.between(prefix+"monthday_from", int64(s.MonthdayFrom), 1, 31)
+			v.between(prefix+"monthday_to", int64(s.MonthdayTo), 1, 31)
+			if s.MonthdayFrom > s.MonthdayTo {
+				v.add(prefix+"monthday_to", "Ensure this value is greater than or equal to monthday_from.")
+			}
+		default:
+			v.add(prefix+"monthday", "Either monthday or monthday_from and monthday_to is required.")
+		}
+	}
+}
+
+var dateTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+func parseDateTime(v *validation, key, value string) (time.Time, bool) {
+	if value == "" {
+		v.add(key, "This field is required.")
+		return time.Time{}, false
+	}
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	v.add(key, "Datetime has wrong format.")
+	return time.Time{}, false
+}
+
+func parseClock(v *validation, key, value string) {
+	if value == "" {
+		v.add(key, "This field is required.")
+		return
+	}
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if _, err := time.Parse(layout, value); err == nil {
+			return
+		}
+	}
+	v.add(key, "Time has wrong format. Use hh:mm[:ss].")
+}
+
+func (c Contact) Validate() error {
+	v := newValidation()
+	v.required("name", c.Name)
+	for i, email := range c.EmailList {
+		if _, err := mail.ParseAddress(email); err != nil {
+			v.add(fmt.Sprintf("email_list.%d", i), "Enter a valid email address.")
+		}
+	}
+	return v.err()
+}
+
+func (s StatusPage) Validate() error {
+	v := newValidation()
+	v.required("name", s.Name)
+	v.choice("visibility_level", s.VisibilityLevel, statusPageVisibilityLevels)
+	v.choice("page_type", s.PageType, statusPageTypes)
+	if s.ContactEmail != "" {
+		if _, err := mail.ParseAddress(s.ContactEmail); err != nil {
+			v.add("contact_email", "Enter a valid email address.")
+		}
+	}
+	if s.Timezone != "" {
+		if _, err := time.LoadLocation(s.Timezone); err != nil {
+			v.add("timezone", "%q is not a valid choice.", s.Timezone)
+		}
+	}
+	return v.err()
+}
+
+func (s StatusPageIncident) Validate() error {
+	v := newValidation()
+	v.required("name", s.Name)
+	v.choice("incident_type", s.IncidentType, incidentTypes)
+	var start, end time.Time
+	var startOK, endOK bool
+	if s.StartsAt != "" {
+		start, startOK = parseDateTime(v, "starts_at", s.StartsAt)
+	}
+	if s.EndsAt != "" {
+		end, endOK = parseDateTime(v, "ends_at", s.EndsAt)
+	}
+	if startOK && endOK && end.Before(start) {
+		v.add("ends_at", "End date must be after start date.")
+	}
+	for i, u := range s.Updates {
+		v.required(fmt.Sprintf("updates.%d.description", i), u.Description)
+		v.required(fmt.Sprintf("updates.%d.incident_state", i), u.IncidentState)
+	}
+	return v.err()
+}
+
+// Validate checks the date range of the report and that each selected
+// service is given by exactly one of its name or primary key, which
+// SLAReportService needs to be marshaled.
+func (r SLAReport) Validate() error {
+	v := newValidation()
+	v.required("name", r.Name)
+	v.choice("default_date_range", r.DefaultDateRange, slaReportDateRanges)
+	if r.ServicesSelected != nil {
+		for i, s := range *r.ServicesSelected {
+			if (s.Name == "") == (s.PK == 0) {
+				v.add(fmt.Sprintf("services_selected.%d", i), "Either a name or a primary key is required, not both.")
+			}
+		}
+	}
+	return v.err()
+}
+
+func (d Dashboard) Validate() error {
+	v := newValidation()
+	v.required("name", d.Name)
+	if d.ServicesNumToShow < 0 {
+		v.add("services_num_to_show", "Ensure this value is greater than or equal to 0.")
+	}
+	if d.AlertsnumToShow < 0 {
+		v.add("alerts_num_to_show", "Ensure this value is greater than or equal to 0.")
+	}
+	return v.err()
+}
+
+// ValidateLocations checks the probe locations of rq against those reported
+// by checks.ListLocations. It returns an error shaped like Validate's.
+func ValidateLocations(ctx context.Context, checks ChecksEndpoint, rq CheckRequest) error {
+	values := make(map[string]reflect.Value)
+	collectJSONFields(values, "", reflect.ValueOf(rq))
+	f, ok := values["locations"]
+	if !ok || f.Len() == 0 {
+		return nil
+	}
+	known, err := checks.ListLocations(ctx)
+	if err != nil {
+		return err
+	}
+	v := newValidation()
+	for i, loc := range f.Interface().([]string) {
+		v.choice(fmt.Sprintf("locations.%d", i), loc, known.Items)
+	}
+	return v.err()
+}
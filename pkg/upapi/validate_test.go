@@ -0,0 +1,150 @@
+package upapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckValidate(t *testing.T) {
+	require.NoError(t, CheckHTTP{Name: "web", Address: "https://example.com", Interval: 5, ExpectStringType: "REGEX"}.Validate())
+
+	rq := CheckHTTP{
+		Interval:         7,
+		Port:             70000,
+		ExpectStringType: "GLOB",
+		UseIPVersion:     "IPV5",
+		UptimeSLA:        decimal.RequireFromString("99.9"),
+	}
+	err := rq.Validate()
+	require.True(t, IsValidation(err))
+	uperr := NewError()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []FieldError{
+		{Key: "msp_address", Path: "Address", Messages: []string{"This field is required."}},
+		{Key: "msp_expect_string_type", Path: "ExpectStringType", Messages: []string{`"GLOB" is not a valid choice.`}},
+		{Key: "msp_interval", Path: "Interval", Messages: []string{"7 is not a valid choice."}},
+		{Key: "msp_port", Path: "Port", Messages: []string{"Ensure this value is less than or equal to 65535."}},
+		{Key: "msp_uptime_sla", Path: "UptimeSLA", Messages: []string{"Ensure this value is between 0 and 1."}},
+		{Key: "msp_use_ip_version", Path: "UseIPVersion", Messages: []string{`"IPV5" is not a valid choice.`}},
+		{Key: "name", Path: "Name", Messages: []string{"This field is required."}},
+	}, uperr.FieldErrors(rq))
+
+	err = CheckTCP{Name: "tcp", Address: "example.com"}.Validate()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []string{"This field is required."}, uperr.Fields.Messages("msp_port"))
+
+	err = CheckDNS{Name: "dns", Address: "example.com", DNSRecordType: "MXX"}.Validate()
+	require.ErrorAs(t, err, &uperr)
+	require.Contains(t, uperr.Fields, "msp_dns_record_type")
+}
+
+func TestCheckCloudStatusValidate(t *testing.T) {
+	rq := CheckCloudStatus{Name: "aws", CloudStatusConfig: CheckCloudStatusConfig{MonitoringType: "SOME"}}
+	err := rq.Validate()
+	uperr := NewError()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []FieldError{
+		{Key: "cloudstatusconfig.monitoring_type", Path: "CloudStatusConfig.MonitoringType", Messages: []string{`"SOME" is not a valid choice.`}},
+		{Key: "cloudstatusconfig.service_name", Path: "CloudStatusConfig.ServiceName", Messages: []string{"Either service_name or group is required."}},
+	}, uperr.FieldErrors(rq))
+
+	rq.CloudStatusConfig = CheckCloudStatusConfig{Group: &CloudStatusGroup{ID: 1}, MonitoringType: "SPECIFIC", ServiceTitles: []string{"EC2"}}
+	require.NoError(t, rq.Validate())
+}
+
+func TestCheckMaintenanceValidate(t *testing.T) {
+	require.NoError(t, CheckMaintenance{
+		State: "SCHEDULED",
+		Schedule: []CheckMaintenanceSchedule{
+			{Type: "WEEKLY", FromTime: "01:00", ToTime: "02:00:00", Weekdays: []int{0, 6}},
+			{Type: "MONTHLY", FromTime: "01:00", ToTime: "02:00", MonthdayFrom: 1, MonthdayTo: 3},
+			{Type: "ONCE", OnceStartDate: "2024-01-01T00:00:00Z", OnceEndDate: "2024-01-02T00:00:00Z"},
+		},
+	}.Validate())
+
+	err := CheckMaintenance{
+		State: "SCHEDULED",
+		Schedule: []CheckMaintenanceSchedule{
+			{Type: "WEEKLY", FromTime: "25:00", ToTime: "02:00", Weekdays: []int{7}},
+			{Type: "ONCE", OnceStartDate: "2024-01-02", OnceEndDate: "2024-01-01"},
+			{Type: "MONTHLY", FromTime: "01:00", ToTime: "02:00"},
+		},
+	}.Validate()
+	uperr := NewError()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []string{
+		"schedule.0.from_time",
+		"schedule.0.weekdays",
+		"schedule.1.once_end_date",
+		"schedule.2.monthday",
+	}, fieldKeys(uperr.Fields))
+
+	err = CheckMaintenance{State: "SCHEDULED"}.Validate()
+	require.ErrorAs(t, err, &uperr)
+	require.Contains(t, uperr.Fields, "schedule")
+}
+
+func TestResourceValidate(t *testing.T) {
+	uperr := NewError()
+
+	require.ErrorAs(t, Contact{Name: "ops", EmailList: []string{"ops@example.com", "nope"}}.Validate(), &uperr)
+	require.Equal(t, []string{"email_list.1"}, fieldKeys(uperr.Fields))
+
+	require.ErrorAs(t, StatusPage{Name: "public", VisibilityLevel: "SECRET", Timezone: "Mars/Olympus"}.Validate(), &uperr)
+	require.Equal(t, []string{"timezone", "visibility_level"}, fieldKeys(uperr.Fields))
+	require.NoError(t, StatusPage{Name: "public", VisibilityLevel: "PUBLIC", Timezone: "Europe/Berlin"}.Validate())
+
+	require.ErrorAs(t, StatusPageIncident{
+		Name:     "outage",
+		StartsAt: "2024-01-02T00:00:00Z",
+		EndsAt:   "2024-01-01T00:00:00Z",
+		Updates:  []IncidentUpdate{{Description: "looking"}},
+	}.Validate(), &uperr)
+	require.Equal(t, []string{"ends_at", "updates.0.incident_state"}, fieldKeys(uperr.Fields))
+
+	require.ErrorAs(t, SLAReport{}.Validate(), &uperr)
+	require.Equal(t, []string{"name"}, fieldKeys(uperr.Fields))
+	require.ErrorAs(t, SLAReport{
+		Name:             "monthly",
+		DefaultDateRange: "LAST_DECADE",
+		ServicesSelected: &[]SLAReportService{{PK: 1}, {Name: "web", PK: 2}, {}, {Name: "api"}},
+	}.Validate(), &uperr)
+	require.Equal(t, []string{"default_date_range", "services_selected.1", "services_selected.2"}, fieldKeys(uperr.Fields))
+	require.NoError(t, SLAReport{Name: "monthly", DefaultDateRange: "LAST_MONTH", ServicesSelected: &[]SLAReportService{{PK: 1}}}.Validate())
+
+	require.ErrorAs(t, Dashboard{Name: "main", ServicesNumToShow: -1}.Validate(), &uperr)
+	require.Equal(t, []string{"services_num_to_show"}, fieldKeys(uperr.Fields))
+}
+
+func TestValidateLocations(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"locations": ["US-East", "GBR"]}`))
+	}))
+	defer ts.Close()
+	api, err := New(WithBaseURL(ts.URL + "/"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, ValidateLocations(ctx, api.Checks(), CheckHTTP{Locations: []string{"GBR"}}))
+
+	err = ValidateLocations(ctx, api.Checks(), CheckHTTP{Locations: []string{"GBR", "Moon"}})
+	uperr := NewError()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []string{`"Moon" is not a valid choice.`}, uperr.Fields.Messages("locations.1"))
+
+	// Checks without locations do not hit the API.
+	require.NoError(t, ValidateLocations(ctx, nil, CheckHeartbeat{Name: "cron"}))
+}
+
+func fieldKeys(f FieldErrors) []string {
+	var keys []string
+	for _, e := range f.Resolve(nil) {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
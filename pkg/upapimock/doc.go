@@ -0,0 +1,27 @@
+// Package upapimock provides testify mocks of upapi.API and of every endpoint
+// interface, including the nested status page endpoints returned by factories
+// such as StatusPagesEndpoint.Components.
+//
+// Expectations are set with the usual testify API. A return value may be
+// given either as a value or as a function with the method's parameters,
+// which is called to compute it:
+//
+//	api := upapimock.NewAPI(t)
+//	checks := upapimock.NewChecksEndpoint(t)
+//	api.On("Checks").Return(checks)
+//	checks.On("Get", mock.Anything, upapi.PrimaryKey(1)).Return(&upapi.Check{PK: 1}, nil)
+//
+// Mocks created by the New functions assert their expectations when the test
+// ends. The mocks are generated from package upapi; run go generate after
+// changing an endpoint interface.
+package upapimock
+
+//go:generate go run ../../internal/mockgen -src ../upapi -out mocks_gen.go
+
+import "github.com/stretchr/testify/mock"
+
+// TestingT is the subset of testing.T used by the mocks.
+type TestingT interface {
+	mock.TestingT
+	Cleanup(func())
+}
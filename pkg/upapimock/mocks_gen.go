@@ -0,0 +1,4138 @@
+// Code generated by internal/mockgen; DO NOT EDIT.
+
+package upapimock
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// API is a mock of upapi.API.
+type API struct {
+	mock.Mock
+}
+
+var _ upapi.API = (*API)(nil)
+
+// NewAPI returns a new API mock asserting its expectations when the test ends.
+func NewAPI(t TestingT) *API {
+	m := new(API)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *API) Alerts() upapi.AlertsEndpoint {
+	ret := m.Called()
+	var r0 upapi.AlertsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.AlertsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.AlertsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Checks() upapi.ChecksEndpoint {
+	ret := m.Called()
+	var r0 upapi.ChecksEndpoint
+	if f, ok := ret.Get(0).(func() upapi.ChecksEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.ChecksEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Contacts() upapi.ContactsEndpoint {
+	ret := m.Called()
+	var r0 upapi.ContactsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.ContactsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.ContactsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Dashboards() upapi.DashboardsEndpoint {
+	ret := m.Called()
+	var r0 upapi.DashboardsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.DashboardsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.DashboardsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Integrations() upapi.IntegrationsEndpoint {
+	ret := m.Called()
+	var r0 upapi.IntegrationsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.IntegrationsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.IntegrationsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Tags() upapi.TagsEndpoint {
+	ret := m.Called()
+	var r0 upapi.TagsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.TagsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.TagsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Outages() upapi.OutagesEndpoint {
+	ret := m.Called()
+	var r0 upapi.OutagesEndpoint
+	if f, ok := ret.Get(0).(func() upapi.OutagesEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.OutagesEndpoint)
+	}
+	return r0
+}
+
+func (m *API) ProbeServers() upapi.ProbeServersEndpoint {
+	ret := m.Called()
+	var r0 upapi.ProbeServersEndpoint
+	if f, ok := ret.Get(0).(func() upapi.ProbeServersEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.ProbeServersEndpoint)
+	}
+	return r0
+}
+
+func (m *API) StatusPages() upapi.StatusPagesEndpoint {
+	ret := m.Called()
+	var r0 upapi.StatusPagesEndpoint
+	if f, ok := ret.Get(0).(func() upapi.StatusPagesEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPagesEndpoint)
+	}
+	return r0
+}
+
+func (m *API) SLAReports() upapi.SLAReportsEndpoint {
+	ret := m.Called()
+	var r0 upapi.SLAReportsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.SLAReportsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.SLAReportsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) ScheduledReports() upapi.ScheduledReportsEndpoint {
+	ret := m.Called()
+	var r0 upapi.ScheduledReportsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.ScheduledReportsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.ScheduledReportsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Credentials() upapi.CredentialEndpoint {
+	ret := m.Called()
+	var r0 upapi.CredentialEndpoint
+	if f, ok := ret.Get(0).(func() upapi.CredentialEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.CredentialEndpoint)
+	}
+	return r0
+}
+
+func (m *API) ServiceVariables() upapi.ServiceVariablesEndpoint {
+	ret := m.Called()
+	var r0 upapi.ServiceVariablesEndpoint
+	if f, ok := ret.Get(0).(func() upapi.ServiceVariablesEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.ServiceVariablesEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Users() upapi.UsersEndpoint {
+	ret := m.Called()
+	var r0 upapi.UsersEndpoint
+	if f, ok := ret.Get(0).(func() upapi.UsersEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.UsersEndpoint)
+	}
+	return r0
+}
+
+func (m *API) PushNotifications() upapi.PushNotificationsEndpoint {
+	ret := m.Called()
+	var r0 upapi.PushNotificationsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.PushNotificationsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.PushNotificationsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) Subaccounts() upapi.SubaccountsEndpoint {
+	ret := m.Called()
+	var r0 upapi.SubaccountsEndpoint
+	if f, ok := ret.Get(0).(func() upapi.SubaccountsEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.SubaccountsEndpoint)
+	}
+	return r0
+}
+
+func (m *API) AccountUsage() upapi.AccountUsageEndpoint {
+	ret := m.Called()
+	var r0 upapi.AccountUsageEndpoint
+	if f, ok := ret.Get(0).(func() upapi.AccountUsageEndpoint); ok {
+		r0 = f()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.AccountUsageEndpoint)
+	}
+	return r0
+}
+
+// AccountUsageEndpoint is a mock of upapi.AccountUsageEndpoint.
+type AccountUsageEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.AccountUsageEndpoint = (*AccountUsageEndpoint)(nil)
+
+// NewAccountUsageEndpoint returns a new AccountUsageEndpoint mock asserting its expectations when the test ends.
+func NewAccountUsageEndpoint(t TestingT) *AccountUsageEndpoint {
+	m := new(AccountUsageEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *AccountUsageEndpoint) Get(a0 context.Context) (*upapi.AccountUsage, error) {
+	ret := m.Called(a0)
+	var r0 *upapi.AccountUsage
+	if f, ok := ret.Get(0).(func(context.Context) *upapi.AccountUsage); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.AccountUsage)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = f(a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// AlertsEndpoint is a mock of upapi.AlertsEndpoint.
+type AlertsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.AlertsEndpoint = (*AlertsEndpoint)(nil)
+
+// NewAlertsEndpoint returns a new AlertsEndpoint mock asserting its expectations when the test ends.
+func NewAlertsEndpoint(t TestingT) *AlertsEndpoint {
+	m := new(AlertsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *AlertsEndpoint) List(a0 context.Context, a1 upapi.AlertListOptions) (*upapi.ListResult[upapi.AlertItem], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.AlertItem]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.AlertListOptions) *upapi.ListResult[upapi.AlertItem]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.AlertItem])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.AlertListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *AlertsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.AlertItem, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.AlertItem
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.AlertItem); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.AlertItem)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *AlertsEndpoint) RootCause(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.AlertRootCause, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.AlertRootCause
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.AlertRootCause); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.AlertRootCause)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *AlertsEndpoint) Ignore(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.AlertItem, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.AlertItem
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.AlertItem); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.AlertItem)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// ChecksEndpoint is a mock of upapi.ChecksEndpoint.
+type ChecksEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.ChecksEndpoint = (*ChecksEndpoint)(nil)
+
+// NewChecksEndpoint returns a new ChecksEndpoint mock asserting its expectations when the test ends.
+func NewChecksEndpoint(t TestingT) *ChecksEndpoint {
+	m := new(ChecksEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *ChecksEndpoint) List(a0 context.Context, a1 upapi.CheckListOptions) (*upapi.ListResult[upapi.Check], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Check]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckListOptions) *upapi.ListResult[upapi.Check]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Check])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+func (m *ChecksEndpoint) Stats(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckStatsOptions) (*upapi.ListResult[upapi.CheckStats], error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.ListResult[upapi.CheckStats]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckStatsOptions) *upapi.ListResult[upapi.CheckStats]); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.CheckStats])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckStatsOptions) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) ListLocations(a0 context.Context) (*upapi.ListResult[string], error) {
+	ret := m.Called(a0)
+	var r0 *upapi.ListResult[string]
+	if f, ok := ret.Get(0).(func(context.Context) *upapi.ListResult[string]); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[string])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = f(a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) Save(a0 context.Context, a1 upapi.CheckRequest, a2 upapi.CheckSaveOptions) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckRequest, upapi.CheckSaveOptions) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckRequest, upapi.CheckSaveOptions) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateIfUnmodified(a0 context.Context, a1 upapi.PrimaryKeyable, a2 time.Time, a3 upapi.CheckRequest, a4 upapi.MergeFunc[upapi.CheckRequest, upapi.Check]) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2, a3, a4)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, time.Time, upapi.CheckRequest, upapi.MergeFunc[upapi.CheckRequest, upapi.Check]) *upapi.Check); ok {
+		r0 = f(a0, a1, a2, a3, a4)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, time.Time, upapi.CheckRequest, upapi.MergeFunc[upapi.CheckRequest, upapi.Check]) error); ok {
+		r1 = f(a0, a1, a2, a3, a4)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateAPI(a0 context.Context, a1 upapi.CheckAPI) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckAPI) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckAPI) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateAPI(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckAPI) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckAPI) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckAPI) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateBlacklist(a0 context.Context, a1 upapi.CheckBlacklist) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckBlacklist) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckBlacklist) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateBlacklist(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckBlacklist) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckBlacklist) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckBlacklist) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateDNS(a0 context.Context, a1 upapi.CheckDNS) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckDNS) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckDNS) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateDNS(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckDNS) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckDNS) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckDNS) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateGroup(a0 context.Context, a1 upapi.CheckGroup) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckGroup) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckGroup) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateGroup(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckGroup) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckGroup) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckGroup) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateHeartbeat(a0 context.Context, a1 upapi.CheckHeartbeat) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckHeartbeat) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckHeartbeat) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateHeartbeat(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckHeartbeat) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckHeartbeat) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckHeartbeat) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateHTTP(a0 context.Context, a1 upapi.CheckHTTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckHTTP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckHTTP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateHTTP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckHTTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckHTTP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckHTTP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateICMP(a0 context.Context, a1 upapi.CheckICMP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckICMP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckICMP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateICMP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckICMP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckICMP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckICMP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateIMAP(a0 context.Context, a1 upapi.CheckIMAP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckIMAP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckIMAP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateIMAP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckIMAP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckIMAP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckIMAP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateMalware(a0 context.Context, a1 upapi.CheckMalware) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckMalware) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckMalware) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateMalware(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckMalware) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckMalware) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckMalware) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateNTP(a0 context.Context, a1 upapi.CheckNTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckNTP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckNTP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateNTP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckNTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckNTP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckNTP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreatePOP(a0 context.Context, a1 upapi.CheckPOP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckPOP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckPOP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdatePOP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckPOP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckPOP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckPOP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateRUM(a0 context.Context, a1 upapi.CheckRUM) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckRUM) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckRUM) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateRUM(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckRUM) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRUM) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRUM) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateRUM2(a0 context.Context, a1 upapi.CheckRUM2) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckRUM2) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckRUM2) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateRUM2(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckRUM2) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRUM2) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRUM2) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateSMTP(a0 context.Context, a1 upapi.CheckSMTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckSMTP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckSMTP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateSMTP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckSMTP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSMTP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSMTP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateSSH(a0 context.Context, a1 upapi.CheckSSH) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckSSH) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckSSH) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateSSH(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckSSH) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSSH) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSSH) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateSSLCert(a0 context.Context, a1 upapi.CheckSSLCert) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckSSLCert) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckSSLCert) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateSSLCert(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckSSLCert) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSSLCert) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckSSLCert) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateTCP(a0 context.Context, a1 upapi.CheckTCP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckTCP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckTCP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateTCP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckTCP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckTCP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckTCP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateTransaction(a0 context.Context, a1 upapi.CheckTransaction) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckTransaction) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckTransaction) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateTransaction(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckTransaction) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckTransaction) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckTransaction) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateUDP(a0 context.Context, a1 upapi.CheckUDP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckUDP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckUDP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateUDP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckUDP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckUDP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckUDP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateWebhook(a0 context.Context, a1 upapi.CheckWebhook) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckWebhook) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckWebhook) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateWebhook(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckWebhook) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckWebhook) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckWebhook) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateWHOIS(a0 context.Context, a1 upapi.CheckWHOIS) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckWHOIS) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckWHOIS) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateWHOIS(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckWHOIS) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckWHOIS) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckWHOIS) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateRDAP(a0 context.Context, a1 upapi.CheckRDAP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckRDAP) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckRDAP) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateRDAP(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckRDAP) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRDAP) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckRDAP) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreatePageSpeed(a0 context.Context, a1 upapi.CheckPageSpeed) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckPageSpeed) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckPageSpeed) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdatePageSpeed(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckPageSpeed) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckPageSpeed) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckPageSpeed) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) CreateCloudStatus(a0 context.Context, a1 upapi.CheckCloudStatus) (*upapi.Check, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CheckCloudStatus) *upapi.Check); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CheckCloudStatus) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateCloudStatus(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckCloudStatus) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckCloudStatus) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckCloudStatus) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) ListCloudStatusGroups(a0 context.Context, a1 upapi.CloudStatusGroupListOptions) (*upapi.ListResult[upapi.CloudStatusGroupListItem], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.CloudStatusGroupListItem]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CloudStatusGroupListOptions) *upapi.ListResult[upapi.CloudStatusGroupListItem]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.CloudStatusGroupListItem])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CloudStatusGroupListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) ListCloudStatusServices(a0 context.Context, a1 upapi.CloudStatusServiceListOptions) (*upapi.ListResult[upapi.CloudStatusService], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.CloudStatusService]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CloudStatusServiceListOptions) *upapi.ListResult[upapi.CloudStatusService]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.CloudStatusService])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CloudStatusServiceListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateMaintenance(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckMaintenance) (*upapi.Check, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Check
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckMaintenance) *upapi.Check); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Check)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckMaintenance) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) GetEscalations(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.CheckEscalations, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.CheckEscalations
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.CheckEscalations); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.CheckEscalations)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ChecksEndpoint) UpdateEscalations(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.CheckEscalations) (*upapi.CheckEscalations, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.CheckEscalations
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckEscalations) *upapi.CheckEscalations); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.CheckEscalations)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.CheckEscalations) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// ContactsEndpoint is a mock of upapi.ContactsEndpoint.
+type ContactsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.ContactsEndpoint = (*ContactsEndpoint)(nil)
+
+// NewContactsEndpoint returns a new ContactsEndpoint mock asserting its expectations when the test ends.
+func NewContactsEndpoint(t TestingT) *ContactsEndpoint {
+	m := new(ContactsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *ContactsEndpoint) List(a0 context.Context, a1 upapi.ContactListOptions) (*upapi.ListResult[upapi.Contact], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Contact]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.ContactListOptions) *upapi.ListResult[upapi.Contact]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Contact])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.ContactListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ContactsEndpoint) Create(a0 context.Context, a1 upapi.Contact) (*upapi.Contact, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Contact
+	if f, ok := ret.Get(0).(func(context.Context, upapi.Contact) *upapi.Contact); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Contact)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.Contact) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ContactsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.Contact) (*upapi.Contact, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Contact
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.Contact) *upapi.Contact); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Contact)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.Contact) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ContactsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Contact, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Contact
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Contact); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Contact)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ContactsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// CredentialEndpoint is a mock of upapi.CredentialEndpoint.
+type CredentialEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.CredentialEndpoint = (*CredentialEndpoint)(nil)
+
+// NewCredentialEndpoint returns a new CredentialEndpoint mock asserting its expectations when the test ends.
+func NewCredentialEndpoint(t TestingT) *CredentialEndpoint {
+	m := new(CredentialEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *CredentialEndpoint) List(a0 context.Context, a1 upapi.CredentialListOptions) (*upapi.ListResult[upapi.Credential], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Credential]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.CredentialListOptions) *upapi.ListResult[upapi.Credential]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Credential])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.CredentialListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *CredentialEndpoint) Create(a0 context.Context, a1 upapi.Credential) (*upapi.Credential, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Credential
+	if f, ok := ret.Get(0).(func(context.Context, upapi.Credential) *upapi.Credential); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Credential)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.Credential) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *CredentialEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.Credential) (*upapi.Credential, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Credential
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.Credential) *upapi.Credential); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Credential)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.Credential) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *CredentialEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Credential, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Credential
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Credential); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Credential)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *CredentialEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// DashboardsEndpoint is a mock of upapi.DashboardsEndpoint.
+type DashboardsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.DashboardsEndpoint = (*DashboardsEndpoint)(nil)
+
+// NewDashboardsEndpoint returns a new DashboardsEndpoint mock asserting its expectations when the test ends.
+func NewDashboardsEndpoint(t TestingT) *DashboardsEndpoint {
+	m := new(DashboardsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *DashboardsEndpoint) List(a0 context.Context, a1 upapi.DashboardListOptions) (*upapi.ListResult[upapi.Dashboard], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Dashboard]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.DashboardListOptions) *upapi.ListResult[upapi.Dashboard]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Dashboard])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.DashboardListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *DashboardsEndpoint) Create(a0 context.Context, a1 upapi.Dashboard) (*upapi.Dashboard, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Dashboard
+	if f, ok := ret.Get(0).(func(context.Context, upapi.Dashboard) *upapi.Dashboard); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Dashboard)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.Dashboard) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *DashboardsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.Dashboard) (*upapi.Dashboard, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Dashboard
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.Dashboard) *upapi.Dashboard); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Dashboard)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.Dashboard) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *DashboardsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Dashboard, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Dashboard
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Dashboard); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Dashboard)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *DashboardsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// IntegrationsEndpoint is a mock of upapi.IntegrationsEndpoint.
+type IntegrationsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.IntegrationsEndpoint = (*IntegrationsEndpoint)(nil)
+
+// NewIntegrationsEndpoint returns a new IntegrationsEndpoint mock asserting its expectations when the test ends.
+func NewIntegrationsEndpoint(t TestingT) *IntegrationsEndpoint {
+	m := new(IntegrationsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *IntegrationsEndpoint) List(a0 context.Context, a1 upapi.IntegrationListOptions) (*upapi.ListResult[upapi.Integration], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Integration]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationListOptions) *upapi.ListResult[upapi.Integration]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Integration])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+func (m *IntegrationsEndpoint) CreateCachet(a0 context.Context, a1 upapi.IntegrationCachet) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationCachet) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationCachet) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateCachet(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationCachet) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationCachet) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationCachet) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateDatadog(a0 context.Context, a1 upapi.IntegrationDatadog) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationDatadog) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationDatadog) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateDatadog(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationDatadog) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationDatadog) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationDatadog) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateGeckoboard(a0 context.Context, a1 upapi.IntegrationGeckoboard) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationGeckoboard) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationGeckoboard) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateGeckoboard(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationGeckoboard) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationGeckoboard) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationGeckoboard) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateJiraServicedesk(a0 context.Context, a1 upapi.IntegrationJiraServicedesk) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationJiraServicedesk) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationJiraServicedesk) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateJiraServiceDesk(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationJiraServicedesk) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationJiraServicedesk) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationJiraServicedesk) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateKlipfolio(a0 context.Context, a1 upapi.IntegrationKlipfolio) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationKlipfolio) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationKlipfolio) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateKlipfolio(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationKlipfolio) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationKlipfolio) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationKlipfolio) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateLibrato(a0 context.Context, a1 upapi.IntegrationLibrato) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationLibrato) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationLibrato) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateLibrato(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationLibrato) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationLibrato) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationLibrato) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateMicrosoftTeams(a0 context.Context, a1 upapi.IntegrationMicrosoftTeams) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationMicrosoftTeams) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationMicrosoftTeams) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateMicrosoftTeams(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationMicrosoftTeams) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationMicrosoftTeams) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationMicrosoftTeams) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateOpsgenie(a0 context.Context, a1 upapi.IntegrationOpsgenie) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationOpsgenie) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationOpsgenie) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateOpsgenie(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationOpsgenie) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationOpsgenie) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationOpsgenie) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreatePagerduty(a0 context.Context, a1 upapi.IntegrationPagerduty) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationPagerduty) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationPagerduty) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdatePagerduty(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationPagerduty) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPagerduty) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPagerduty) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreatePushbullet(a0 context.Context, a1 upapi.IntegrationPushbullet) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationPushbullet) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationPushbullet) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdatePushbullet(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationPushbullet) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPushbullet) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPushbullet) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreatePushover(a0 context.Context, a1 upapi.IntegrationPushover) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationPushover) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationPushover) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdatePushover(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationPushover) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPushover) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationPushover) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateSlack(a0 context.Context, a1 upapi.IntegrationSlack) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationSlack) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationSlack) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateSlack(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationSlack) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationSlack) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationSlack) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateStatus(a0 context.Context, a1 upapi.IntegrationStatus) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationStatus) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationStatus) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateStatus(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationStatus) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationStatus) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationStatus) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateStatuspage(a0 context.Context, a1 upapi.IntegrationStatuspage) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationStatuspage) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationStatuspage) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateStatuspage(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationStatuspage) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationStatuspage) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationStatuspage) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateTwitter(a0 context.Context, a1 upapi.IntegrationTwitter) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationTwitter) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationTwitter) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateTwitter(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationTwitter) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationTwitter) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationTwitter) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateVictorops(a0 context.Context, a1 upapi.IntegrationVictorops) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationVictorops) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationVictorops) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateVictorops(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationVictorops) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationVictorops) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationVictorops) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateWavefront(a0 context.Context, a1 upapi.IntegrationWavefront) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationWavefront) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationWavefront) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateWavefront(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationWavefront) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationWavefront) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationWavefront) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateWebhook(a0 context.Context, a1 upapi.IntegrationWebhook) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationWebhook) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationWebhook) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateWebhook(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationWebhook) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationWebhook) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationWebhook) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) CreateZapier(a0 context.Context, a1 upapi.IntegrationZapier) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.IntegrationZapier) *upapi.Integration); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.IntegrationZapier) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *IntegrationsEndpoint) UpdateZapier(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.IntegrationZapier) (*upapi.Integration, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Integration
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationZapier) *upapi.Integration); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Integration)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.IntegrationZapier) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// OutagesEndpoint is a mock of upapi.OutagesEndpoint.
+type OutagesEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.OutagesEndpoint = (*OutagesEndpoint)(nil)
+
+// NewOutagesEndpoint returns a new OutagesEndpoint mock asserting its expectations when the test ends.
+func NewOutagesEndpoint(t TestingT) *OutagesEndpoint {
+	m := new(OutagesEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *OutagesEndpoint) List(a0 context.Context, a1 upapi.OutageListOptions) (*upapi.ListResult[upapi.Outage], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Outage]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.OutageListOptions) *upapi.ListResult[upapi.Outage]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Outage])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.OutageListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *OutagesEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Outage, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Outage
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Outage); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Outage)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// ProbeServersEndpoint is a mock of upapi.ProbeServersEndpoint.
+type ProbeServersEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.ProbeServersEndpoint = (*ProbeServersEndpoint)(nil)
+
+// NewProbeServersEndpoint returns a new ProbeServersEndpoint mock asserting its expectations when the test ends.
+func NewProbeServersEndpoint(t TestingT) *ProbeServersEndpoint {
+	m := new(ProbeServersEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *ProbeServersEndpoint) List(a0 context.Context) (*upapi.ListResult[upapi.ProbeServer], error) {
+	ret := m.Called(a0)
+	var r0 *upapi.ListResult[upapi.ProbeServer]
+	if f, ok := ret.Get(0).(func(context.Context) *upapi.ListResult[upapi.ProbeServer]); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.ProbeServer])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = f(a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// PushNotificationsEndpoint is a mock of upapi.PushNotificationsEndpoint.
+type PushNotificationsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.PushNotificationsEndpoint = (*PushNotificationsEndpoint)(nil)
+
+// NewPushNotificationsEndpoint returns a new PushNotificationsEndpoint mock asserting its expectations when the test ends.
+func NewPushNotificationsEndpoint(t TestingT) *PushNotificationsEndpoint {
+	m := new(PushNotificationsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *PushNotificationsEndpoint) List(a0 context.Context, a1 upapi.PushNotificationProfileListOptions) (*upapi.ListResult[upapi.PushNotificationProfile], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.PushNotificationProfile]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PushNotificationProfileListOptions) *upapi.ListResult[upapi.PushNotificationProfile]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.PushNotificationProfile])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PushNotificationProfileListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *PushNotificationsEndpoint) Create(a0 context.Context, a1 upapi.PushNotificationProfileCreateRequest) (*upapi.PushNotificationProfile, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.PushNotificationProfile
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PushNotificationProfileCreateRequest) *upapi.PushNotificationProfile); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.PushNotificationProfile)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PushNotificationProfileCreateRequest) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *PushNotificationsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.PushNotificationProfile, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.PushNotificationProfile
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.PushNotificationProfile); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.PushNotificationProfile)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *PushNotificationsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.PushNotificationProfileUpdateRequest) (*upapi.PushNotificationProfile, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.PushNotificationProfile
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.PushNotificationProfileUpdateRequest) *upapi.PushNotificationProfile); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.PushNotificationProfile)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.PushNotificationProfileUpdateRequest) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *PushNotificationsEndpoint) UpdateIfUnmodified(a0 context.Context, a1 upapi.PrimaryKeyable, a2 time.Time, a3 upapi.PushNotificationProfileUpdateRequest, a4 upapi.MergeFunc[upapi.PushNotificationProfileUpdateRequest, upapi.PushNotificationProfile]) (*upapi.PushNotificationProfile, error) {
+	ret := m.Called(a0, a1, a2, a3, a4)
+	var r0 *upapi.PushNotificationProfile
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, time.Time, upapi.PushNotificationProfileUpdateRequest, upapi.MergeFunc[upapi.PushNotificationProfileUpdateRequest, upapi.PushNotificationProfile]) *upapi.PushNotificationProfile); ok {
+		r0 = f(a0, a1, a2, a3, a4)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.PushNotificationProfile)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, time.Time, upapi.PushNotificationProfileUpdateRequest, upapi.MergeFunc[upapi.PushNotificationProfileUpdateRequest, upapi.PushNotificationProfile]) error); ok {
+		r1 = f(a0, a1, a2, a3, a4)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *PushNotificationsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// SLAReportsEndpoint is a mock of upapi.SLAReportsEndpoint.
+type SLAReportsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.SLAReportsEndpoint = (*SLAReportsEndpoint)(nil)
+
+// NewSLAReportsEndpoint returns a new SLAReportsEndpoint mock asserting its expectations when the test ends.
+func NewSLAReportsEndpoint(t TestingT) *SLAReportsEndpoint {
+	m := new(SLAReportsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *SLAReportsEndpoint) List(a0 context.Context, a1 upapi.SLAReportListOptions) (*upapi.ListResult[upapi.SLAReport], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.SLAReport]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.SLAReportListOptions) *upapi.ListResult[upapi.SLAReport]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.SLAReport])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.SLAReportListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsEndpoint) Create(a0 context.Context, a1 upapi.SLAReport) (*upapi.SLAReport, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.SLAReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.SLAReport) *upapi.SLAReport); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.SLAReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.SLAReport) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.SLAReport) (*upapi.SLAReport, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.SLAReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.SLAReport) *upapi.SLAReport); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.SLAReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.SLAReport) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.SLAReport, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.SLAReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.SLAReport); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.SLAReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+func (m *SLAReportsEndpoint) ReportingGroups(a0 upapi.PrimaryKeyable) upapi.SLAReportsGroupsEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.SLAReportsGroupsEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.SLAReportsGroupsEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.SLAReportsGroupsEndpoint)
+	}
+	return r0
+}
+
+// SLAReportsGroupsEndpoint is a mock of upapi.SLAReportsGroupsEndpoint.
+type SLAReportsGroupsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.SLAReportsGroupsEndpoint = (*SLAReportsGroupsEndpoint)(nil)
+
+// NewSLAReportsGroupsEndpoint returns a new SLAReportsGroupsEndpoint mock asserting its expectations when the test ends.
+func NewSLAReportsGroupsEndpoint(t TestingT) *SLAReportsGroupsEndpoint {
+	m := new(SLAReportsGroupsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *SLAReportsGroupsEndpoint) Create(a0 context.Context, a1 upapi.SLAReportGroup) (*upapi.SLAReportGroup, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.SLAReportGroup
+	if f, ok := ret.Get(0).(func(context.Context, upapi.SLAReportGroup) *upapi.SLAReportGroup); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.SLAReportGroup)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.SLAReportGroup) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsGroupsEndpoint) List(a0 context.Context, a1 upapi.SLAReportGroupListOptions) (*upapi.ListResult[upapi.SLAReportGroup], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.SLAReportGroup]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.SLAReportGroupListOptions) *upapi.ListResult[upapi.SLAReportGroup]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.SLAReportGroup])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.SLAReportGroupListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsGroupsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.SLAReportGroup, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.SLAReportGroup
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.SLAReportGroup); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.SLAReportGroup)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SLAReportsGroupsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// ScheduledReportsEndpoint is a mock of upapi.ScheduledReportsEndpoint.
+type ScheduledReportsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.ScheduledReportsEndpoint = (*ScheduledReportsEndpoint)(nil)
+
+// NewScheduledReportsEndpoint returns a new ScheduledReportsEndpoint mock asserting its expectations when the test ends.
+func NewScheduledReportsEndpoint(t TestingT) *ScheduledReportsEndpoint {
+	m := new(ScheduledReportsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *ScheduledReportsEndpoint) List(a0 context.Context, a1 upapi.ScheduledReportListOptions) (*upapi.ListResult[upapi.ScheduledReport], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.ScheduledReport]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.ScheduledReportListOptions) *upapi.ListResult[upapi.ScheduledReport]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.ScheduledReport])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.ScheduledReportListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ScheduledReportsEndpoint) Create(a0 context.Context, a1 upapi.ScheduledReport) (*upapi.ScheduledReport, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ScheduledReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.ScheduledReport) *upapi.ScheduledReport); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ScheduledReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.ScheduledReport) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ScheduledReportsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.ScheduledReport) (*upapi.ScheduledReport, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.ScheduledReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.ScheduledReport) *upapi.ScheduledReport); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ScheduledReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.ScheduledReport) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ScheduledReportsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.ScheduledReport, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ScheduledReport
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.ScheduledReport); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ScheduledReport)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ScheduledReportsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// ServiceVariablesEndpoint is a mock of upapi.ServiceVariablesEndpoint.
+type ServiceVariablesEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.ServiceVariablesEndpoint = (*ServiceVariablesEndpoint)(nil)
+
+// NewServiceVariablesEndpoint returns a new ServiceVariablesEndpoint mock asserting its expectations when the test ends.
+func NewServiceVariablesEndpoint(t TestingT) *ServiceVariablesEndpoint {
+	m := new(ServiceVariablesEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *ServiceVariablesEndpoint) List(a0 context.Context, a1 upapi.ServiceVariableListOptions) (*upapi.ListResult[upapi.ServiceVariable], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.ServiceVariable]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.ServiceVariableListOptions) *upapi.ListResult[upapi.ServiceVariable]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.ServiceVariable])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.ServiceVariableListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ServiceVariablesEndpoint) Create(a0 context.Context, a1 upapi.ServiceVariableCreateRequest) (*upapi.ServiceVariable, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ServiceVariable
+	if f, ok := ret.Get(0).(func(context.Context, upapi.ServiceVariableCreateRequest) *upapi.ServiceVariable); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ServiceVariable)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.ServiceVariableCreateRequest) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ServiceVariablesEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.ServiceVariable, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ServiceVariable
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.ServiceVariable); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ServiceVariable)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ServiceVariablesEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.ServiceVariableUpdateRequest) (*upapi.ServiceVariable, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.ServiceVariable
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.ServiceVariableUpdateRequest) *upapi.ServiceVariable); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ServiceVariable)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.ServiceVariableUpdateRequest) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *ServiceVariablesEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageComponentEndpoint is a mock of upapi.StatusPageComponentEndpoint.
+type StatusPageComponentEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageComponentEndpoint = (*StatusPageComponentEndpoint)(nil)
+
+// NewStatusPageComponentEndpoint returns a new StatusPageComponentEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageComponentEndpoint(t TestingT) *StatusPageComponentEndpoint {
+	m := new(StatusPageComponentEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageComponentEndpoint) Create(a0 context.Context, a1 upapi.StatusPageComponent) (*upapi.StatusPageComponent, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageComponent
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageComponent) *upapi.StatusPageComponent); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageComponent)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageComponent) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageComponentEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageComponent) (*upapi.StatusPageComponent, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageComponent
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageComponent) *upapi.StatusPageComponent); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageComponent)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageComponent) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageComponentEndpoint) List(a0 context.Context, a1 upapi.StatusPageComponentListOptions) (*upapi.ListResult[upapi.StatusPageComponent], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageComponent]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageComponentListOptions) *upapi.ListResult[upapi.StatusPageComponent]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageComponent])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageComponentListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageComponentEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageComponent, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageComponent
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageComponent); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageComponent)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageComponentEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageCurrentStatusEndpoint is a mock of upapi.StatusPageCurrentStatusEndpoint.
+type StatusPageCurrentStatusEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageCurrentStatusEndpoint = (*StatusPageCurrentStatusEndpoint)(nil)
+
+// NewStatusPageCurrentStatusEndpoint returns a new StatusPageCurrentStatusEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageCurrentStatusEndpoint(t TestingT) *StatusPageCurrentStatusEndpoint {
+	m := new(StatusPageCurrentStatusEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageCurrentStatusEndpoint) Get(a0 context.Context) (*upapi.StatusPageCurrentStatus, error) {
+	ret := m.Called(a0)
+	var r0 *upapi.StatusPageCurrentStatus
+	if f, ok := ret.Get(0).(func(context.Context) *upapi.StatusPageCurrentStatus); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageCurrentStatus)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = f(a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// StatusPageIncidentEndpoint is a mock of upapi.StatusPageIncidentEndpoint.
+type StatusPageIncidentEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageIncidentEndpoint = (*StatusPageIncidentEndpoint)(nil)
+
+// NewStatusPageIncidentEndpoint returns a new StatusPageIncidentEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageIncidentEndpoint(t TestingT) *StatusPageIncidentEndpoint {
+	m := new(StatusPageIncidentEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageIncidentEndpoint) Create(a0 context.Context, a1 upapi.StatusPageIncident) (*upapi.StatusPageIncident, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageIncident
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageIncident) *upapi.StatusPageIncident); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageIncident)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageIncident) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageIncidentEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageIncident) (*upapi.StatusPageIncident, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageIncident
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageIncident) *upapi.StatusPageIncident); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageIncident)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageIncident) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageIncidentEndpoint) List(a0 context.Context, a1 upapi.StatusPageIncidentListOptions) (*upapi.ListResult[upapi.StatusPageIncident], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageIncident]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageIncidentListOptions) *upapi.ListResult[upapi.StatusPageIncident]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageIncident])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageIncidentListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageIncidentEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageIncident, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageIncident
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageIncident); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageIncident)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageIncidentEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageMetricEndpoint is a mock of upapi.StatusPageMetricEndpoint.
+type StatusPageMetricEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageMetricEndpoint = (*StatusPageMetricEndpoint)(nil)
+
+// NewStatusPageMetricEndpoint returns a new StatusPageMetricEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageMetricEndpoint(t TestingT) *StatusPageMetricEndpoint {
+	m := new(StatusPageMetricEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageMetricEndpoint) Create(a0 context.Context, a1 upapi.StatusPageMetric) (*upapi.StatusPageMetric, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageMetric
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageMetric) *upapi.StatusPageMetric); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageMetric)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageMetric) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageMetricEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageMetric) (*upapi.StatusPageMetric, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageMetric
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageMetric) *upapi.StatusPageMetric); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageMetric)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageMetric) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageMetricEndpoint) List(a0 context.Context, a1 upapi.StatusPageMetricListOptions) (*upapi.ListResult[upapi.StatusPageMetric], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageMetric]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageMetricListOptions) *upapi.ListResult[upapi.StatusPageMetric]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageMetric])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageMetricListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageMetricEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageMetric, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageMetric
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageMetric); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageMetric)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageMetricEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageStatusHistoryEndpoint is a mock of upapi.StatusPageStatusHistoryEndpoint.
+type StatusPageStatusHistoryEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageStatusHistoryEndpoint = (*StatusPageStatusHistoryEndpoint)(nil)
+
+// NewStatusPageStatusHistoryEndpoint returns a new StatusPageStatusHistoryEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageStatusHistoryEndpoint(t TestingT) *StatusPageStatusHistoryEndpoint {
+	m := new(StatusPageStatusHistoryEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageStatusHistoryEndpoint) List(a0 context.Context, a1 upapi.StatusPageStatusHistoryListOptions) (*upapi.ListResult[upapi.StatusPageStatusHistory], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageStatusHistory]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageStatusHistoryListOptions) *upapi.ListResult[upapi.StatusPageStatusHistory]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageStatusHistory])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageStatusHistoryListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageStatusHistoryEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageStatusHistory, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageStatusHistory
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageStatusHistory); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageStatusHistory)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+// StatusPageSubsDomainAllowListEndpoint is a mock of upapi.StatusPageSubsDomainAllowListEndpoint.
+type StatusPageSubsDomainAllowListEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageSubsDomainAllowListEndpoint = (*StatusPageSubsDomainAllowListEndpoint)(nil)
+
+// NewStatusPageSubsDomainAllowListEndpoint returns a new StatusPageSubsDomainAllowListEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageSubsDomainAllowListEndpoint(t TestingT) *StatusPageSubsDomainAllowListEndpoint {
+	m := new(StatusPageSubsDomainAllowListEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageSubsDomainAllowListEndpoint) Create(a0 context.Context, a1 upapi.StatusPageSubsDomainAllowList) (*upapi.StatusPageSubsDomainAllowList, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubsDomainAllowList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubsDomainAllowList) *upapi.StatusPageSubsDomainAllowList); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainAllowList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubsDomainAllowList) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainAllowListEndpoint) List(a0 context.Context, a1 upapi.StatusPageSubsDomainAllowListListOptions) (*upapi.ListResult[upapi.StatusPageSubsDomainAllowList], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageSubsDomainAllowList]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubsDomainAllowListListOptions) *upapi.ListResult[upapi.StatusPageSubsDomainAllowList]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageSubsDomainAllowList])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubsDomainAllowListListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainAllowListEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageSubsDomainAllowList) (*upapi.StatusPageSubsDomainAllowList, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageSubsDomainAllowList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageSubsDomainAllowList) *upapi.StatusPageSubsDomainAllowList); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainAllowList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageSubsDomainAllowList) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainAllowListEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageSubsDomainAllowList, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubsDomainAllowList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageSubsDomainAllowList); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainAllowList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainAllowListEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageSubsDomainBlockListEndpoint is a mock of upapi.StatusPageSubsDomainBlockListEndpoint.
+type StatusPageSubsDomainBlockListEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageSubsDomainBlockListEndpoint = (*StatusPageSubsDomainBlockListEndpoint)(nil)
+
+// NewStatusPageSubsDomainBlockListEndpoint returns a new StatusPageSubsDomainBlockListEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageSubsDomainBlockListEndpoint(t TestingT) *StatusPageSubsDomainBlockListEndpoint {
+	m := new(StatusPageSubsDomainBlockListEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageSubsDomainBlockListEndpoint) Create(a0 context.Context, a1 upapi.StatusPageSubsDomainBlockList) (*upapi.StatusPageSubsDomainBlockList, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubsDomainBlockList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubsDomainBlockList) *upapi.StatusPageSubsDomainBlockList); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainBlockList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubsDomainBlockList) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainBlockListEndpoint) List(a0 context.Context, a1 upapi.StatusPageSubsDomainBlockListListOptions) (*upapi.ListResult[upapi.StatusPageSubsDomainBlockList], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageSubsDomainBlockList]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubsDomainBlockListListOptions) *upapi.ListResult[upapi.StatusPageSubsDomainBlockList]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageSubsDomainBlockList])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubsDomainBlockListListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainBlockListEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageSubsDomainBlockList) (*upapi.StatusPageSubsDomainBlockList, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageSubsDomainBlockList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageSubsDomainBlockList) *upapi.StatusPageSubsDomainBlockList); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainBlockList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageSubsDomainBlockList) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainBlockListEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageSubsDomainBlockList, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubsDomainBlockList
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageSubsDomainBlockList); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubsDomainBlockList)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubsDomainBlockListEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageSubscriberEndpoint is a mock of upapi.StatusPageSubscriberEndpoint.
+type StatusPageSubscriberEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageSubscriberEndpoint = (*StatusPageSubscriberEndpoint)(nil)
+
+// NewStatusPageSubscriberEndpoint returns a new StatusPageSubscriberEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageSubscriberEndpoint(t TestingT) *StatusPageSubscriberEndpoint {
+	m := new(StatusPageSubscriberEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageSubscriberEndpoint) Create(a0 context.Context, a1 upapi.StatusPageSubscriber) (*upapi.StatusPageSubscriber, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubscriber
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubscriber) *upapi.StatusPageSubscriber); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubscriber)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubscriber) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubscriberEndpoint) List(a0 context.Context, a1 upapi.StatusPageSubscriberListOptions) (*upapi.ListResult[upapi.StatusPageSubscriber], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageSubscriber]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageSubscriberListOptions) *upapi.ListResult[upapi.StatusPageSubscriber]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageSubscriber])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageSubscriberListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubscriberEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageSubscriber, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageSubscriber
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageSubscriber); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageSubscriber)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageSubscriberEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPageUserEndpoint is a mock of upapi.StatusPageUserEndpoint.
+type StatusPageUserEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPageUserEndpoint = (*StatusPageUserEndpoint)(nil)
+
+// NewStatusPageUserEndpoint returns a new StatusPageUserEndpoint mock asserting its expectations when the test ends.
+func NewStatusPageUserEndpoint(t TestingT) *StatusPageUserEndpoint {
+	m := new(StatusPageUserEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPageUserEndpoint) Create(a0 context.Context, a1 upapi.StatusPageUser) (*upapi.StatusPageUser, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageUser
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageUser) *upapi.StatusPageUser); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageUser)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageUser) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageUserEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPageUser) (*upapi.StatusPageUser, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPageUser
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageUser) *upapi.StatusPageUser); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageUser)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPageUser) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageUserEndpoint) List(a0 context.Context, a1 upapi.StatusPageUserListOptions) (*upapi.ListResult[upapi.StatusPageUser], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPageUser]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageUserListOptions) *upapi.ListResult[upapi.StatusPageUser]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPageUser])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageUserListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageUserEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPageUser, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPageUser
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPageUser); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPageUser)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPageUserEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// StatusPagesEndpoint is a mock of upapi.StatusPagesEndpoint.
+type StatusPagesEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.StatusPagesEndpoint = (*StatusPagesEndpoint)(nil)
+
+// NewStatusPagesEndpoint returns a new StatusPagesEndpoint mock asserting its expectations when the test ends.
+func NewStatusPagesEndpoint(t TestingT) *StatusPagesEndpoint {
+	m := new(StatusPagesEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *StatusPagesEndpoint) List(a0 context.Context, a1 upapi.StatusPageListOptions) (*upapi.ListResult[upapi.StatusPage], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.StatusPage]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPageListOptions) *upapi.ListResult[upapi.StatusPage]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.StatusPage])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPageListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPagesEndpoint) Create(a0 context.Context, a1 upapi.StatusPage) (*upapi.StatusPage, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPage
+	if f, ok := ret.Get(0).(func(context.Context, upapi.StatusPage) *upapi.StatusPage); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPage)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.StatusPage) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPagesEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.StatusPage) (*upapi.StatusPage, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.StatusPage
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPage) *upapi.StatusPage); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPage)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.StatusPage) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPagesEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.StatusPage, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.StatusPage
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.StatusPage); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.StatusPage)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *StatusPagesEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) Components(a0 upapi.PrimaryKeyable) upapi.StatusPageComponentEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageComponentEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageComponentEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageComponentEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) Incidents(a0 upapi.PrimaryKeyable) upapi.StatusPageIncidentEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageIncidentEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageIncidentEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageIncidentEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) Metrics(a0 upapi.PrimaryKeyable) upapi.StatusPageMetricEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageMetricEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageMetricEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageMetricEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) Subscribers(a0 upapi.PrimaryKeyable) upapi.StatusPageSubscriberEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageSubscriberEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageSubscriberEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageSubscriberEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) SubscriptionDomainAllowList(a0 upapi.PrimaryKeyable) upapi.StatusPageSubsDomainAllowListEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageSubsDomainAllowListEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageSubsDomainAllowListEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageSubsDomainAllowListEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) SubscriptionDomainBlockList(a0 upapi.PrimaryKeyable) upapi.StatusPageSubsDomainBlockListEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageSubsDomainBlockListEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageSubsDomainBlockListEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageSubsDomainBlockListEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) Users(a0 upapi.PrimaryKeyable) upapi.StatusPageUserEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageUserEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageUserEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageUserEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) CurrentStatus(a0 upapi.PrimaryKeyable) upapi.StatusPageCurrentStatusEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageCurrentStatusEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageCurrentStatusEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageCurrentStatusEndpoint)
+	}
+	return r0
+}
+
+func (m *StatusPagesEndpoint) StatusHistory(a0 upapi.PrimaryKeyable) upapi.StatusPageStatusHistoryEndpoint {
+	ret := m.Called(a0)
+	var r0 upapi.StatusPageStatusHistoryEndpoint
+	if f, ok := ret.Get(0).(func(upapi.PrimaryKeyable) upapi.StatusPageStatusHistoryEndpoint); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(upapi.StatusPageStatusHistoryEndpoint)
+	}
+	return r0
+}
+
+// SubaccountsEndpoint is a mock of upapi.SubaccountsEndpoint.
+type SubaccountsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.SubaccountsEndpoint = (*SubaccountsEndpoint)(nil)
+
+// NewSubaccountsEndpoint returns a new SubaccountsEndpoint mock asserting its expectations when the test ends.
+func NewSubaccountsEndpoint(t TestingT) *SubaccountsEndpoint {
+	m := new(SubaccountsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *SubaccountsEndpoint) List(a0 context.Context) ([]upapi.Subaccount, error) {
+	ret := m.Called(a0)
+	var r0 []upapi.Subaccount
+	if f, ok := ret.Get(0).(func(context.Context) []upapi.Subaccount); ok {
+		r0 = f(a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]upapi.Subaccount)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = f(a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SubaccountsEndpoint) Create(a0 context.Context, a1 upapi.SubaccountCreateRequest) (*upapi.Subaccount, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Subaccount
+	if f, ok := ret.Get(0).(func(context.Context, upapi.SubaccountCreateRequest) *upapi.Subaccount); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Subaccount)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.SubaccountCreateRequest) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SubaccountsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Subaccount, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Subaccount
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Subaccount); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Subaccount)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SubaccountsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.SubaccountUpdateRequest) (*upapi.Subaccount, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Subaccount
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.SubaccountUpdateRequest) *upapi.Subaccount); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Subaccount)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.SubaccountUpdateRequest) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *SubaccountsEndpoint) TransferPacks(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.SubaccountPacks) error {
+	ret := m.Called(a0, a1, a2)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.SubaccountPacks) error); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// TagsEndpoint is a mock of upapi.TagsEndpoint.
+type TagsEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.TagsEndpoint = (*TagsEndpoint)(nil)
+
+// NewTagsEndpoint returns a new TagsEndpoint mock asserting its expectations when the test ends.
+func NewTagsEndpoint(t TestingT) *TagsEndpoint {
+	m := new(TagsEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *TagsEndpoint) List(a0 context.Context, a1 upapi.TagListOptions) (*upapi.ListResult[upapi.Tag], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.Tag]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.TagListOptions) *upapi.ListResult[upapi.Tag]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.Tag])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.TagListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *TagsEndpoint) Create(a0 context.Context, a1 upapi.Tag) (*upapi.Tag, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Tag
+	if f, ok := ret.Get(0).(func(context.Context, upapi.Tag) *upapi.Tag); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Tag)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.Tag) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *TagsEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.Tag, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.Tag
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.Tag); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Tag)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *TagsEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.Tag) (*upapi.Tag, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.Tag
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.Tag) *upapi.Tag); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.Tag)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.Tag) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *TagsEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+// UsersEndpoint is a mock of upapi.UsersEndpoint.
+type UsersEndpoint struct {
+	mock.Mock
+}
+
+var _ upapi.UsersEndpoint = (*UsersEndpoint)(nil)
+
+// NewUsersEndpoint returns a new UsersEndpoint mock asserting its expectations when the test ends.
+func NewUsersEndpoint(t TestingT) *UsersEndpoint {
+	m := new(UsersEndpoint)
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *UsersEndpoint) List(a0 context.Context, a1 upapi.UserListOptions) (*upapi.ListResult[upapi.User], error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.ListResult[upapi.User]
+	if f, ok := ret.Get(0).(func(context.Context, upapi.UserListOptions) *upapi.ListResult[upapi.User]); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.ListResult[upapi.User])
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.UserListOptions) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *UsersEndpoint) Create(a0 context.Context, a1 upapi.UserCreateRequest) (*upapi.User, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.User
+	if f, ok := ret.Get(0).(func(context.Context, upapi.UserCreateRequest) *upapi.User); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.User)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.UserCreateRequest) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *UsersEndpoint) Get(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.User, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.User
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.User); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.User)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *UsersEndpoint) Update(a0 context.Context, a1 upapi.PrimaryKeyable, a2 upapi.UserUpdateRequest) (*upapi.User, error) {
+	ret := m.Called(a0, a1, a2)
+	var r0 *upapi.User
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable, upapi.UserUpdateRequest) *upapi.User); ok {
+		r0 = f(a0, a1, a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.User)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable, upapi.UserUpdateRequest) error); ok {
+		r1 = f(a0, a1, a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *UsersEndpoint) Delete(a0 context.Context, a1 upapi.PrimaryKeyable) error {
+	ret := m.Called(a0, a1)
+	var r0 error
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+	return r0
+}
+
+func (m *UsersEndpoint) Deactivate(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.User, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.User
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.User); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.User)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
+
+func (m *UsersEndpoint) Reactivate(a0 context.Context, a1 upapi.PrimaryKeyable) (*upapi.User, error) {
+	ret := m.Called(a0, a1)
+	var r0 *upapi.User
+	if f, ok := ret.Get(0).(func(context.Context, upapi.PrimaryKeyable) *upapi.User); ok {
+		r0 = f(a0, a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*upapi.User)
+	}
+	var r1 error
+	if f, ok := ret.Get(1).(func(context.Context, upapi.PrimaryKeyable) error); ok {
+		r1 = f(a0, a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+	return r0, r1
+}
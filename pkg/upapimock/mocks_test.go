@@ -0,0 +1,46 @@
+package upapimock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestNestedEndpoint(t *testing.T) {
+	ctx := context.Background()
+	api := NewAPI(t)
+	pages := NewStatusPagesEndpoint(t)
+	components := NewStatusPageComponentEndpoint(t)
+
+	api.On("StatusPages").Return(pages)
+	pages.On("Components", upapi.PrimaryKey(7)).Return(components)
+	components.On("List", mock.Anything, mock.MatchedBy(func(opts upapi.StatusPageComponentListOptions) bool {
+		return opts.Page == 2
+	})).Return(&upapi.ListResult[upapi.StatusPageComponent]{
+		Items: []upapi.StatusPageComponent{{PK: 1, Name: "API"}},
+	}, nil).Once()
+
+	res, err := api.StatusPages().Components(upapi.PrimaryKey(7)).List(ctx, upapi.StatusPageComponentListOptions{Page: 2})
+	require.NoError(t, err)
+	require.Equal(t, "API", res.Items[0].Name)
+}
+
+func TestReturnFunc(t *testing.T) {
+	ctx := context.Background()
+	checks := NewChecksEndpoint(t)
+
+	checks.On("Get", mock.Anything, mock.Anything).Return(func(_ context.Context, pk upapi.PrimaryKeyable) *upapi.Check {
+		return &upapi.Check{PK: int64(pk.PrimaryKey())}
+	}, nil).Once()
+	checks.On("Delete", mock.Anything, upapi.PrimaryKey(3)).Return(errors.New("boom")).Once()
+
+	check, err := checks.Get(ctx, upapi.PrimaryKey(5))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), check.PK)
+	require.EqualError(t, checks.Delete(ctx, upapi.PrimaryKey(3)), "boom")
+}
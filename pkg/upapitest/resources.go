@@ -0,0 +1,484 @@
+package upapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+type collectionAction func(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, rest []string, body []byte)
+
+type itemAction func(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte)
+
+// resource describes how the API serves a collection.
+type resource struct {
+	readOnly  bool     // no create, update or delete; items are seeded with Server.Add
+	noCreate  bool     // items are created through collection actions only
+	plainList bool     // the listing is a bare JSON array rather than a page
+	bareWrite bool     // create and update answer with the bare item
+	key       string   // primary key field, "pk" when empty
+	required  []string // fields checked on create and update
+	writeOnly []string // fields accepted but never returned, e.g. passwords
+	search    []string // fields matched by ?search=
+	filters   []string // fields matched by equally named query parameters
+	nested    map[string]*resource
+
+	defaults          func(s *Server, name string, item map[string]any)
+	validate          func(item map[string]any, fields map[string]any)
+	collectionActions map[string]collectionAction
+	itemActions       map[string]itemAction
+}
+
+// keyField returns the name of the primary key field of the items of res.
+func (res *resource) keyField() string {
+	if res == nil || res.key == "" {
+		return "pk"
+	}
+	return res.key
+}
+
+var resources = map[string]*resource{
+	"checks": {
+		noCreate:          true,
+		required:          []string{"name"},
+		writeOnly:         []string{"msp_password"},
+		search:            []string{"name", "msp_address"},
+		filters:           []string{"monitoring_service_type", "is_paused"},
+		defaults:          checkDefaults,
+		validate:          validateCheck,
+		collectionActions: checkCollectionActions(),
+		itemActions: map[string]itemAction{
+			"stats":       checkStats,
+			"maintenance": checkMaintenance,
+			"escalations": checkEscalations,
+		},
+	},
+	"contacts": {
+		required: []string{"name"},
+		search:   []string{"name"},
+	},
+	"check-tags": {
+		required: []string{"tag"},
+		search:   []string{"tag"},
+	},
+	"integrations": {
+		noCreate:          true,
+		required:          []string{"name"},
+		search:            []string{"name"},
+		filters:           []string{"module"},
+		collectionActions: integrationCollectionActions(),
+	},
+	"dashboards": {
+		required: []string{"name"},
+		search:   []string{"name"},
+	},
+	"sla-reports": {
+		required: []string{"name"},
+		search:   []string{"name"},
+		nested: map[string]*resource{
+			"groups": {
+				key:      "id",
+				required: []string{"name"},
+			},
+		},
+	},
+	"scheduled-reports": {
+		required: []string{"name"},
+		search:   []string{"name"},
+	},
+	"credentials": {
+		key:       "id",
+		required:  []string{"display_name", "credential_type"},
+		writeOnly: []string{"secret"},
+		search:    []string{"display_name"},
+	},
+	"servicevariables": {
+		key:      "id",
+		required: []string{"variable_name"},
+		filters:  []string{"service"},
+	},
+	"outages": {
+		readOnly: true,
+		search:   []string{"check_name"},
+		filters:  []string{"check_pk", "check_monitoring_service_type"},
+	},
+	"alerts": {
+		readOnly: true,
+		search:   []string{"check_name"},
+		filters:  []string{"check_pk", "check_monitoring_service_type"},
+		collectionActions: map[string]collectionAction{
+			"alert": alertRootCause,
+		},
+		itemActions: map[string]itemAction{
+			"ignore": alertIgnore,
+		},
+	},
+	"statuspages": {
+		required:  []string{"name"},
+		writeOnly: []string{"auth_password"},
+		search:    []string{"name"},
+		nested: map[string]*resource{
+			"components": {
+				required: []string{"name"},
+				search:   []string{"name"},
+			},
+			"incidents": {
+				required: []string{"name"},
+				search:   []string{"name"},
+				defaults: func(s *Server, name string, item map[string]any) {
+					setDefault(item, "starts_at", now())
+				},
+			},
+			"metrics": {
+				required: []string{"name"},
+				search:   []string{"name"},
+			},
+			"subscribers": {
+				key:      "id",
+				required: []string{"target", "type"},
+			},
+			"subscription-domain-allow-list": {
+				key:      "id",
+				required: []string{"domain"},
+			},
+			"subscription-domain-block-list": {
+				key:      "id",
+				required: []string{"domain"},
+			},
+			"users": {
+				required: []string{"email"},
+			},
+		},
+	},
+	"users": {
+		required:  []string{"email"},
+		writeOnly: []string{"password"},
+		search:    []string{"email", "first_name", "last_name"},
+		defaults: func(s *Server, name string, item map[string]any) {
+			if _, ok := item["is_active"]; !ok {
+				item["is_active"] = true
+			}
+		},
+		itemActions: map[string]itemAction{
+			"deactivate": userActivation(false),
+			"reactivate": userActivation(true),
+		},
+	},
+	"auth/subaccounts": {
+		plainList: true,
+		bareWrite: true,
+		required:  []string{"name"},
+		itemActions: map[string]itemAction{
+			"allocation": subaccountAllocation,
+		},
+	},
+}
+
+// lookupResource returns the resource serving the collection path, e.g.
+// "checks" or "statuspages/1/components".
+func lookupResource(path string) (*resource, bool) {
+	segs := strings.Split(path, "/")
+	if segs[0] == "auth" && len(segs) > 1 {
+		segs = append([]string{"auth/" + segs[1]}, segs[2:]...)
+	}
+	res, ok := resources[segs[0]]
+	switch {
+	case !ok:
+		return nil, false
+	case len(segs) == 1:
+		return res, true
+	case len(segs) == 3:
+		if _, err := strconv.ParseInt(segs[1], 10, 64); err != nil {
+			return nil, false
+		}
+		nested, ok := res.nested[segs[2]]
+		return nested, ok
+	}
+	return nil, false
+}
+
+// checkType describes a check created through checks/add-<name>/.
+type checkType struct {
+	name     string
+	required []string
+}
+
+var checkTypes = map[string]checkType{
+	"add-api":         {"API", []string{"msp_script"}},
+	"add-blacklist":   {"BLACKLIST", []string{"msp_address"}},
+	"add-cloudstatus": {"CLOUDSTATUS", nil},
+	"add-dns":         {"DNS", []string{"msp_address"}},
+	"add-group":       {"GROUP", nil},
+	"add-heartbeat":   {"HEARTBEAT", nil},
+	"add-http":        {"HTTP", []string{"msp_address"}},
+	"add-icmp":        {"ICMP", []string{"msp_address"}},
+	"add-imap":        {"IMAP", []string{"msp_address"}},
+	"add-malware":     {"MALWARE", []string{"msp_address"}},
+	"add-ntp":         {"NTP", []string{"msp_address"}},
+	"add-pagespeed":   {"PAGESPEED", []string{"msp_address"}},
+	"add-pop":         {"POP", []string{"msp_address"}},
+	"add-rdap":        {"RDAP", []string{"msp_address"}},
+	"add-rum":         {"RUM", []string{"msp_address"}},
+	"add-rum2":        {"RUM2", []string{"msp_address"}},
+	"add-smtp":        {"SMTP", []string{"msp_address"}},
+	"add-ssh":         {"SSH", []string{"msp_address"}},
+	"add-ssl-cert":    {"SSL_CERT", []string{"msp_address"}},
+	"add-tcp":         {"TCP", []string{"msp_address", "msp_port"}},
+	"add-transaction": {"TRANSACTION", []string{"msp_script"}},
+	"add-udp":         {"UDP", []string{"msp_address", "msp_port"}},
+	"add-webhook":     {"WEBHOOK", nil},
+	"add-whois":       {"WHOIS", []string{"msp_address"}},
+}
+
+func checkCollectionActions() map[string]collectionAction {
+	actions := map[string]collectionAction{
+		"locations": checkLocations,
+	}
+	for path, typ := range checkTypes {
+		typ := typ
+		actions[path] = func(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, rest []string, body []byte) {
+			if r.Method != http.MethodPost || len(rest) > 0 {
+				methodNotAllowed(w, r)
+				return
+			}
+			s.create(w, res, name, body, func(item map[string]any) {
+				item["check_type"] = typ.name
+			})
+		}
+	}
+	return actions
+}
+
+// integrationModules lists the integrations created through
+// integrations/add-<module>/, which also names their module.
+var integrationModules = []string{
+	"cachet", "datadog", "geckoboard", "jiraservicedesk", "klipfolio", "librato", "microsoft-teams", "opsgenie",
+	"pagerduty", "pushbullet", "pushover", "slack", "status", "statuspage", "twitter", "victorops", "wavefront",
+	"webhook", "zapier",
+}
+
+func integrationCollectionActions() map[string]collectionAction {
+	actions := make(map[string]collectionAction, len(integrationModules))
+	for _, module := range integrationModules {
+		module := module
+		actions["add-"+module] = func(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, rest []string, body []byte) {
+			if r.Method != http.MethodPost || len(rest) > 0 {
+				methodNotAllowed(w, r)
+				return
+			}
+			s.create(w, res, name, body, func(item map[string]any) {
+				item["module"] = module
+			})
+		}
+	}
+	return actions
+}
+
+func checkDefaults(s *Server, name string, item map[string]any) {
+	pk := pkOf(item)
+	typ, _ := item["check_type"].(string)
+	setDefault(item, "check_type", "HTTP")
+	setDefault(item, "monitoring_service_type", item["check_type"])
+	setDefault(item, "stats_url", fmt.Sprintf("%s/api/v1/checks/%d/stats/", s.URL, pk))
+	setDefault(item, "alerts_url", fmt.Sprintf("%s/api/v1/alerts/?check_pk=%d", s.URL, pk))
+	setDefault(item, "created_at", now())
+	setDefault(item, "modified_at", item["created_at"])
+	setDefault(item, "msp_interval", 5)
+	if _, ok := item["state_is_up"]; !ok {
+		item["state_is_up"] = true
+	}
+	if _, ok := item["is_paused"]; !ok {
+		item["is_paused"] = false
+	}
+	switch typ {
+	case "HEARTBEAT":
+		setDefault(item, "heartbeat_url", fmt.Sprintf("%s/heartbeat/%d/", s.URL, pk))
+	case "WEBHOOK":
+		setDefault(item, "webhook_url", fmt.Sprintf("%s/webhook/%d/", s.URL, pk))
+	}
+}
+
+func validateCheck(item map[string]any, fields map[string]any) {
+	typ, _ := item["check_type"].(string)
+	for _, t := range checkTypes {
+		if t.name != typ {
+			continue
+		}
+		for _, f := range t.required {
+			if isBlank(lookup(item, f)) {
+				setFieldError(fields, f, "This field is required.")
+			}
+		}
+	}
+	if typ == "CLOUDSTATUS" &&
+		isBlank(lookup(item, "cloudstatusconfig.service_name")) && isBlank(lookup(item, "cloudstatusconfig.group")) {
+		setFieldError(fields, "cloudstatusconfig.service_name", "Either service_name or group is required.")
+	}
+	if v, ok := item["msp_interval"]; ok {
+		if n, ok := number(v); ok && n <= 0 {
+			setFieldError(fields, "msp_interval", "Ensure this value is greater than or equal to 1.")
+		}
+	}
+}
+
+func checkLocations(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, rest []string, body []byte) {
+	if r.Method != http.MethodGet || len(rest) > 0 {
+		methodNotAllowed(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"locations": s.locations})
+}
+
+func checkStats(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, r)
+		return
+	}
+	q := r.URL.Query()
+	stats := append([]upapi.CheckStats{}, s.stats[pkOf(item)]...)
+	var outages, downtime int64
+	for i := range stats {
+		outages += stats[i].Outages
+		downtime += stats[i].DowntimeSecs
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"start_date": q.Get("start_date"),
+		"end_date":   q.Get("end_date"),
+		"totals": map[string]any{
+			"outages":       outages,
+			"downtime_secs": downtime,
+		},
+		"statistics": stats,
+	})
+}
+
+func checkMaintenance(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+	if r.Method != http.MethodPatch && r.Method != http.MethodPut {
+		methodNotAllowed(w, r)
+		return
+	}
+	maintenance, err := decodeObject(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARSE_ERROR", err.Error(), nil)
+		return
+	}
+	fields := make(map[string]any)
+	schedule, _ := maintenance["schedule"].([]any)
+	for i := range schedule {
+		entry, _ := schedule[i].(map[string]any)
+		switch entry["type"] {
+		case "ONCE", "DAILY", "WEEKLY", "MONTHLY":
+		default:
+			setFieldError(fields, fmt.Sprintf("schedule.%d.type", i), fmt.Sprintf("%q is not a valid choice.", entry["type"]))
+		}
+	}
+	if len(fields) > 0 {
+		writeValidationError(w, fields)
+		return
+	}
+	existing, _ := item["maintenance"].(map[string]any)
+	if existing == nil {
+		existing = make(map[string]any)
+		item["maintenance"] = existing
+	}
+	merge(existing, maintenance)
+	item["modified_at"] = now()
+	s.writeItem(w, res, item)
+}
+
+func checkEscalations(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+	if r.Method != http.MethodPatch && r.Method != http.MethodPut {
+		methodNotAllowed(w, r)
+		return
+	}
+	data, err := decodeObject(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARSE_ERROR", err.Error(), nil)
+		return
+	}
+	escalations, _ := data["escalations"].([]any)
+	fields := make(map[string]any)
+	for i := range escalations {
+		entry, _ := escalations[i].(map[string]any)
+		if isBlank(entry["contact_groups"]) {
+			setFieldError(fields, fmt.Sprintf("escalations.%d.contact_groups", i), "This field is required.")
+		}
+	}
+	if len(fields) > 0 {
+		writeValidationError(w, fields)
+		return
+	}
+	item["escalations"] = deepCopy(escalations)
+	item["modified_at"] = now()
+	s.writeItem(w, res, item)
+}
+
+func alertIgnore(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, r)
+		return
+	}
+	ignored, _ := item["ignored"].(bool)
+	item["ignored"] = !ignored
+	writeJSON(w, http.StatusOK, item)
+}
+
+// alertRootCause serves alerts/alert/{pk}/root-cause/.
+func alertRootCause(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, rest []string, body []byte) {
+	if len(rest) != 2 || rest[1] != "root-cause" {
+		notFound(w)
+		return
+	}
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, r)
+		return
+	}
+	pk, err := strconv.ParseInt(rest[0], 10, 64)
+	if err != nil {
+		notFound(w)
+		return
+	}
+	item := s.collection(res, name).get(pk)
+	if item == nil {
+		notFound(w)
+		return
+	}
+	rc := make(map[string]any)
+	for _, k := range []string{"pk", "url", "created_at", "monitoring_server_name", "monitoring_server_ipv4", "monitoring_server_ipv6", "location", "output", "root_cause_data"} {
+		if v, ok := item[k]; ok {
+			rc[k] = v
+		}
+	}
+	writeJSON(w, http.StatusOK, rc)
+}
+
+func userActivation(active bool) itemAction {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w, r)
+			return
+		}
+		item["is_active"] = active
+		s.writeItem(w, res, item)
+	}
+}
+
+func subaccountAllocation(s *Server, w http.ResponseWriter, r *http.Request, res *resource, name string, item map[string]any, body []byte) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, r)
+		return
+	}
+	num := r.URL.Query().Get("num")
+	if data, err := decodeObject(body); err == nil && data["num"] != nil {
+		num = fmt.Sprint(data["num"])
+	}
+	if _, err := strconv.ParseInt(num, 10, 64); err != nil {
+		writeValidationError(w, map[string]any{"num": []any{"A valid integer is required."}})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"num": json.Number(num)})
+}
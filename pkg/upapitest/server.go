@@ -0,0 +1,768 @@
+// Package upapitest provides an in-memory fake of the Uptime.com REST API for
+// testing code built on upapi without network access:
+//
+//	fake := upapitest.NewServer()
+//	defer fake.Close()
+//
+//	api, err := upapi.New(upapi.WithBaseURL(fake.URL), upapi.WithToken("test"))
+//
+// The fake emulates checks (every add-* variant, stats, maintenance and
+// escalations), contacts, tags, integrations, outages, alerts, dashboards, SLA
+// reports with their groups, scheduled reports, credentials, service
+// variables, status pages with their nested collections, users and
+// subaccounts. It reproduces the quirks of
+// the real API: paginated listings with count/next/previous, items returned
+// bare by GET but wrapped in "results" by create and update, validation
+// errors in the messages.error_fields format, and 429 responses on demand.
+package upapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 250
+)
+
+// Server is a fake Uptime.com API. The API root is served both at URL and at
+// URL + "/api/v1/".
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	token       string
+	collections map[string]*collection
+	required    map[string][]string
+	stats       map[int64][]upapi.CheckStats
+	locations   []string
+	failures    []failure
+	requests    []Request
+}
+
+// Request is a request received by the fake.
+type Request struct {
+	Method string
+	Path   string // relative to the API root, e.g. "checks/add-http/"
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+type failure struct {
+	remaining  int
+	status     int
+	retryAfter time.Duration
+}
+
+// NewServer starts a fake with no data.
+func NewServer() *Server {
+	s := &Server{
+		collections: make(map[string]*collection),
+		required:    make(map[string][]string),
+		stats:       make(map[int64][]upapi.CheckStats),
+		locations:   []string{"US-East", "US-West", "US-Central", "GBR", "DEU", "AUS"},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// RequireToken makes the fake reject requests not authorized with token,
+// using either the Token or the Bearer scheme.
+func (s *Server) RequireToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// Require adds fields which must be set for items of collection to pass
+// validation, on top of the built-in rules. Nested fields use dotted paths,
+// e.g. Require("checks", "sslconfig.ssl_cert_protocol").
+func (s *Server) Require(collection string, fields ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.required[collection] = append(s.required[collection], fields...)
+}
+
+// Throttle answers the next n requests with 429 Too Many Requests and the
+// given Retry-After.
+func (s *Server) Throttle(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{remaining: n, status: http.StatusTooManyRequests, retryAfter: retryAfter})
+}
+
+// Fail answers the next n requests with status, e.g. 503 Service Unavailable.
+func (s *Server) Fail(n int, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{remaining: n, status: status})
+}
+
+// SetStats sets the statistics returned for the check pk.
+func (s *Server) SetStats(pk int64, stats ...upapi.CheckStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats[pk] = stats
+}
+
+// SetLocations sets the probe locations returned by checks/locations.
+func (s *Server) SetLocations(locations ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.locations = locations
+}
+
+// Add stores item, any value encoding to a JSON object, in collection and
+// returns its primary key. Items without a pk get the next free one, and
+// server-generated fields are filled in as if the item was created through
+// the API. collection is an API path such as "checks", "check-tags",
+// "auth/subaccounts" or "statuspages/1/components".
+func (s *Server) Add(collection string, item any) int64 {
+	m, err := toObject(item)
+	if err != nil {
+		panic(fmt.Sprintf("upapitest: %s: %v", collection, err))
+	}
+	collection = strings.Trim(collection, "/")
+	res, ok := lookupResource(collection)
+	if !ok {
+		panic(fmt.Sprintf("upapitest: unknown collection %q", collection))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pk := s.collection(res, collection).insert(m)
+	s.fill(res, collection, m)
+	return pk
+}
+
+// Get decodes the item pk of collection into v and reports whether it exists.
+func (s *Server) Get(collection string, pk int64, v any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item := s.collectionAt(collection).get(pk)
+	if item == nil {
+		return false
+	}
+	b, err := json.Marshal(item)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+// List decodes the items of collection, ordered by primary key, into v, a
+// pointer to a slice, and reports whether they could be decoded.
+func (s *Server) List(collection string, v any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := json.Marshal(s.collectionAt(collection).items)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+// Len returns the number of items in collection.
+func (s *Server) Len(collection string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.collectionAt(collection).items)
+}
+
+// collectionAt returns the collection at the API path collection.
+func (s *Server) collectionAt(collection string) *collection {
+	collection = strings.Trim(collection, "/")
+	res, _ := lookupResource(collection)
+	return s.collection(res, collection)
+}
+
+// Requests returns every request received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// collection returns the items of the collection name, served by res.
+func (s *Server) collection(res *resource, name string) *collection {
+	c, ok := s.collections[name]
+	if !ok {
+		c = &collection{key: res.keyField()}
+		s.collections[name] = c
+	}
+	return c
+}
+
+func (s *Server) itemURL(collection string, pk int64) string {
+	return fmt.Sprintf("%s/api/v1/%s/%d/", s.URL, collection, pk)
+}
+
+// fill sets server-generated fields missing from item.
+func (s *Server) fill(res *resource, collection string, item map[string]any) {
+	setDefault(item, "url", s.itemURL(collection, intOf(item[res.keyField()])))
+	if res.defaults != nil {
+		res.defaults(s, collection, item)
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARSE_ERROR", err.Error(), nil)
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path + "/",
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	if len(s.failures) > 0 {
+		f := &s.failures[0]
+		f.remaining--
+		status, retryAfter := f.status, f.retryAfter
+		if f.remaining <= 0 {
+			s.failures = s.failures[1:]
+		}
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			writeError(w, status, "THROTTLED", "Request was throttled.", nil)
+			return
+		}
+		writeError(w, status, strconv.Itoa(status), http.StatusText(status), nil)
+		return
+	}
+
+	if s.token != "" {
+		auth := r.Header.Get("Authorization")
+		if auth != "Token "+s.token && auth != "Bearer "+s.token {
+			writeError(w, http.StatusUnauthorized, "NOT_AUTHENTICATED", "Authentication credentials were not provided.", nil)
+			return
+		}
+	}
+
+	s.route(w, r, path, body)
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	segs := strings.Split(path, "/")
+	if segs[0] == "auth" && len(segs) > 1 {
+		segs = append([]string{"auth/" + segs[1]}, segs[2:]...)
+	}
+	res, ok := resources[segs[0]]
+	if !ok {
+		notFound(w)
+		return
+	}
+	name, rest := segs[0], segs[1:]
+
+	// Nested collections, e.g. statuspages/{pk}/components.
+	if len(rest) >= 2 {
+		if nested, ok := res.nested[rest[1]]; ok {
+			pk, err := strconv.ParseInt(rest[0], 10, 64)
+			if err != nil || s.collection(res, name).get(pk) == nil {
+				notFound(w)
+				return
+			}
+			res, name, rest = nested, strings.Join(segs[:3], "/"), rest[2:]
+		}
+	}
+
+	if len(rest) == 0 {
+		switch {
+		case r.Method == http.MethodGet:
+			s.list(w, r, res, name)
+		case r.Method == http.MethodPost && !res.readOnly && !res.noCreate:
+			s.create(w, res, name, body, nil)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	pk, err := strconv.ParseInt(rest[0], 10, 64)
+	if err != nil {
+		action, ok := res.collectionActions[rest[0]]
+		if !ok {
+			notFound(w)
+			return
+		}
+		action(s, w, r, res, name, rest[1:], body)
+		return
+	}
+	item := s.collection(res, name).get(pk)
+	if item == nil {
+		notFound(w)
+		return
+	}
+	if len(rest) == 2 {
+		action, ok := res.itemActions[rest[1]]
+		if !ok {
+			notFound(w)
+			return
+		}
+		action(s, w, r, res, name, item, body)
+		return
+	}
+	if len(rest) > 2 {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, item)
+	case (r.Method == http.MethodPatch || r.Method == http.MethodPut) && !res.readOnly:
+		s.update(w, res, name, item, body, r.Method == http.MethodPut)
+	case r.Method == http.MethodDelete && !res.readOnly:
+		s.collection(res, name).remove(pk)
+		prefix := fmt.Sprintf("%s/%d/", name, pk)
+		for k := range s.collections {
+			if strings.HasPrefix(k, prefix) {
+				delete(s.collections, k)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, res *resource, name string) {
+	q := r.URL.Query()
+	items := s.collection(res, name).filter(func(item map[string]any) bool {
+		if search := strings.ToLower(q.Get("search")); search != "" {
+			found := false
+			for _, f := range res.search {
+				if v, ok := item[f].(string); ok && strings.Contains(strings.ToLower(v), search) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		for _, f := range res.filters {
+			if v := q.Get(f); v != "" && fmt.Sprint(item[f]) != v {
+				return false
+			}
+		}
+		if tags := q["tag"]; len(tags) > 0 && !containsAny(item["tags"], tags) {
+			return false
+		}
+		return true
+	})
+	if ordering := q.Get("ordering"); ordering != "" {
+		field, desc := strings.TrimPrefix(ordering, "-"), strings.HasPrefix(ordering, "-")
+		sort.SliceStable(items, func(i, j int) bool {
+			if desc {
+				return less(items[j][field], items[i][field])
+			}
+			return less(items[i][field], items[j][field])
+		})
+	}
+
+	if res.plainList {
+		writeJSON(w, http.StatusOK, items)
+		return
+	}
+
+	page, size := 1, defaultPageSize
+	if v := q.Get("page"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			page = n
+		}
+	}
+	if v := q.Get("page_size"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			size = min(n, maxPageSize)
+		}
+	}
+	start := (page - 1) * size
+	if start > 0 && start >= len(items) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Invalid page.", nil)
+		return
+	}
+	end := min(start+size, len(items))
+
+	pageURL := func(p int) any {
+		u := *r.URL
+		v := u.Query()
+		v.Set("page", strconv.Itoa(p))
+		u.RawQuery = v.Encode()
+		return s.URL + u.RequestURI()
+	}
+	var next, previous any
+	if end < len(items) {
+		next = pageURL(page + 1)
+	}
+	if page > 1 {
+		previous = pageURL(page - 1)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"count":    len(items),
+		"next":     next,
+		"previous": previous,
+		"results":  items[start:end],
+	})
+}
+
+// create validates and stores the item decoded from body. init, when not
+// nil, prepares the item before validation.
+func (s *Server) create(w http.ResponseWriter, res *resource, name string, body []byte, init func(map[string]any)) {
+	item, err := decodeObject(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARSE_ERROR", err.Error(), nil)
+		return
+	}
+	delete(item, res.keyField())
+	if init != nil {
+		init(item)
+	}
+	if fields := s.validate(res, name, item); len(fields) > 0 {
+		writeValidationError(w, fields)
+		return
+	}
+	for _, f := range res.writeOnly {
+		delete(item, f)
+	}
+	s.collection(res, name).insert(item)
+	s.fill(res, name, item)
+	s.writeItem(w, res, item)
+}
+
+func (s *Server) update(w http.ResponseWriter, res *resource, name string, item map[string]any, body []byte, replace bool) {
+	patch, err := decodeObject(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARSE_ERROR", err.Error(), nil)
+		return
+	}
+	updated := make(map[string]any)
+	if !replace {
+		updated = deepCopy(item).(map[string]any)
+	}
+	merge(updated, patch)
+	for _, k := range []string{res.keyField(), "url"} {
+		updated[k] = item[k]
+	}
+	if fields := s.validate(res, name, updated); len(fields) > 0 {
+		writeValidationError(w, fields)
+		return
+	}
+	for _, f := range res.writeOnly {
+		delete(updated, f)
+	}
+	if _, ok := updated["modified_at"]; ok {
+		updated["modified_at"] = now()
+	}
+	for k := range item {
+		delete(item, k)
+	}
+	merge(item, updated)
+	s.fill(res, name, item)
+	s.writeItem(w, res, item)
+}
+
+// writeItem answers a create or update the way the API does: wrapped in
+// "results" for most resources, bare for the others.
+func (s *Server) writeItem(w http.ResponseWriter, res *resource, item map[string]any) {
+	if res.bareWrite {
+		writeJSON(w, http.StatusOK, item)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"messages": map[string]any{},
+		"results":  item,
+	})
+}
+
+// validate returns the field errors of item in the nested error_fields
+// format, or nil when it is valid.
+func (s *Server) validate(res *resource, name string, item map[string]any) map[string]any {
+	fields := make(map[string]any)
+	key := name
+	if parts := strings.Split(name, "/"); len(parts) == 3 {
+		key = parts[2]
+	}
+	required := append(append([]string(nil), res.required...), s.required[key]...)
+	for _, f := range required {
+		if isBlank(lookup(item, f)) {
+			setFieldError(fields, f, "This field is required.")
+		}
+	}
+	if res.validate != nil {
+		res.validate(item, fields)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+type collection struct {
+	key    string // primary key field of the items
+	nextPK int64
+	items  []map[string]any
+}
+
+func (c *collection) pkOf(item map[string]any) int64 {
+	return intOf(item[c.key])
+}
+
+func (c *collection) insert(item map[string]any) int64 {
+	pk := c.pkOf(item)
+	if pk <= 0 {
+		c.nextPK++
+		pk = c.nextPK
+	} else if pk > c.nextPK {
+		c.nextPK = pk
+	}
+	item[c.key] = pk
+	c.remove(pk)
+	c.items = append(c.items, item)
+	sort.SliceStable(c.items, func(i, j int) bool {
+		return c.pkOf(c.items[i]) < c.pkOf(c.items[j])
+	})
+	return pk
+}
+
+func (c *collection) get(pk int64) map[string]any {
+	for _, item := range c.items {
+		if c.pkOf(item) == pk {
+			return item
+		}
+	}
+	return nil
+}
+
+func (c *collection) remove(pk int64) {
+	for i, item := range c.items {
+		if c.pkOf(item) == pk {
+			c.items = append(c.items[:i], c.items[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *collection) filter(keep func(map[string]any) bool) []map[string]any {
+	out := make([]map[string]any, 0, len(c.items))
+	for _, item := range c.items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string, fields map[string]any) {
+	messages := map[string]any{
+		"errors":        true,
+		"error_code":    code,
+		"error_message": message,
+	}
+	if fields != nil {
+		messages["error_fields"] = fields
+	}
+	writeJSON(w, status, map[string]any{"messages": messages})
+}
+
+func writeValidationError(w http.ResponseWriter, fields map[string]any) {
+	writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "One or more fields failed validation.", fields)
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "Not found.", nil)
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("Method %q not allowed.", r.Method), nil)
+}
+
+func toObject(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObject(b)
+}
+
+func decodeObject(b []byte) (map[string]any, error) {
+	item := make(map[string]any)
+	if len(bytes.TrimSpace(b)) == 0 {
+		return item, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func pkOf(item map[string]any) int64 {
+	return intOf(item["pk"])
+}
+
+func intOf(v any) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case json.Number:
+		n, _ := v.Int64()
+		return n
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func setDefault(item map[string]any, key string, value any) {
+	if isBlank(item[key]) {
+		item[key] = value
+	}
+}
+
+// isBlank reports whether v is missing or empty. Zero time.Time values, which
+// encoding/json never omits, count as empty.
+func isBlank(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == "" || v == "0001-01-01T00:00:00Z"
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// lookup returns the value at the dotted path in item.
+func lookup(item map[string]any, path string) any {
+	var v any = item
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
+
+// setFieldError records msg for the dotted path, nesting objects the way the
+// API reports errors of structured fields.
+func setFieldError(fields map[string]any, path string, msg string) {
+	keys := strings.Split(path, ".")
+	m := fields
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := m[k].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			m[k] = next
+		}
+		m = next
+	}
+	last := keys[len(keys)-1]
+	msgs, _ := m[last].([]any)
+	m[last] = append(msgs, msg)
+}
+
+func merge(dst, src map[string]any) {
+	for k, v := range src {
+		if sm, ok := v.(map[string]any); ok {
+			if dm, ok := dst[k].(map[string]any); ok {
+				merge(dm, sm)
+				continue
+			}
+		}
+		dst[k] = deepCopy(v)
+	}
+}
+
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k := range v {
+			out[k] = deepCopy(v[k])
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = deepCopy(v[i])
+		}
+		return out
+	}
+	return v
+}
+
+func containsAny(list any, values []string) bool {
+	items, _ := list.([]any)
+	for _, item := range items {
+		for _, v := range values {
+			if fmt.Sprint(item) == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func less(a, b any) bool {
+	an, aok := number(a)
+	bn, bok := number(b)
+	if aok && bok {
+		return an < bn
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func number(v any) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
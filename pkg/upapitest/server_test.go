@@ -0,0 +1,257 @@
+package upapitest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func newTestAPI(t *testing.T, opts ...upapi.Option) (*Server, upapi.API) {
+	fake := NewServer()
+	t.Cleanup(fake.Close)
+	api, err := upapi.New(append([]upapi.Option{upapi.WithBaseURL(fake.URL), upapi.WithToken("test")}, opts...)...)
+	require.NoError(t, err)
+	return fake, api
+}
+
+func TestChecks(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	created, err := api.Checks().CreateHTTP(ctx, upapi.CheckHTTP{
+		Name:     "web",
+		Address:  "https://example.com",
+		Password: "hunter2",
+		Tags:     []string{"prod"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created.PK)
+	require.Equal(t, "HTTP", created.CheckType)
+	require.Equal(t, fake.URL+"/api/v1/checks/1/", created.URL)
+	require.Empty(t, created.Password)
+
+	hb, err := api.Checks().CreateHeartbeat(ctx, upapi.CheckHeartbeat{Name: "cron"})
+	require.NoError(t, err)
+	require.Equal(t, "HEARTBEAT", hb.CheckType)
+	require.NotEmpty(t, hb.HeartbeatURL)
+
+	got, err := api.Checks().Get(ctx, created)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com", got.Address)
+
+	updated, err := api.Checks().UpdateHTTP(ctx, created, upapi.CheckHTTP{Name: "website"})
+	require.NoError(t, err)
+	require.Equal(t, "website", updated.Name)
+	require.Equal(t, "https://example.com", updated.Address)
+
+	list, err := api.Checks().List(ctx, upapi.CheckListOptions{Tag: []string{"prod"}})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), list.TotalCount)
+	require.Equal(t, "website", list.Items[0].Name)
+
+	maint, err := api.Checks().UpdateMaintenance(ctx, created, upapi.CheckMaintenance{
+		State:    "SCHEDULED",
+		Schedule: []upapi.CheckMaintenanceSchedule{{Type: "WEEKLY", FromTime: "01:00:00", ToTime: "02:00:00", Weekdays: []int{1}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SCHEDULED", maint.Maintenance.State)
+
+	groups := []string{"Default"}
+	esc, err := api.Checks().UpdateEscalations(ctx, created, upapi.CheckEscalations{
+		Escalations: []upapi.CheckEscalation{{WaitTime: 300, ContactGroups: &groups}},
+	})
+	require.NoError(t, err)
+	require.Len(t, esc.Escalations, 1)
+
+	fake.SetStats(created.PK, upapi.CheckStats{Date: "2024-01-01", Outages: 2, DowntimeSecs: 60})
+	stats, err := api.Checks().Stats(ctx, created, upapi.CheckStatsOptions{StartDate: "2024-01-01", EndDate: "2024-01-01"})
+	require.NoError(t, err)
+	require.Len(t, stats.Items, 1)
+	require.Equal(t, int64(2), stats.Items[0].Outages)
+
+	require.NoError(t, api.Checks().Delete(ctx, created))
+	_, err = api.Checks().Get(ctx, created)
+	require.True(t, upapi.IsNotFound(err))
+	require.Equal(t, 1, fake.Len("checks"))
+}
+
+func TestValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	_, api := newTestAPI(t)
+
+	rq := upapi.CheckCloudStatus{Name: "aws"}
+	_, err := api.Checks().CreateCloudStatus(ctx, rq)
+	require.True(t, upapi.IsValidation(err))
+	uperr := upapi.NewError()
+	require.ErrorAs(t, err, &uperr)
+	require.Equal(t, []upapi.FieldError{{
+		Key:      "cloudstatusconfig.service_name",
+		Path:     "CloudStatusConfig.ServiceName",
+		Messages: []string{"Either service_name or group is required."},
+	}}, uperr.FieldErrors(rq))
+
+	_, err = api.Checks().CreateTCP(ctx, upapi.CheckTCP{Name: "tcp", Address: "example.com"})
+	require.True(t, upapi.IsValidation(err))
+	require.ErrorAs(t, err, &uperr)
+	require.Contains(t, uperr.Fields, "msp_port")
+}
+
+func TestPagination(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	for i := 0; i < 25; i++ {
+		fake.Add("contacts", upapi.Contact{Name: "contact"})
+	}
+	page, err := api.Contacts().List(ctx, upapi.ContactListOptions{Page: 3, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, int64(25), page.TotalCount)
+	require.Len(t, page.Items, 5)
+	require.Equal(t, int64(21), page.Items[0].PK)
+
+	all, err := upapi.ListAll(ctx, api.Contacts(), upapi.ContactListOptions{}, upapi.WithPageSize(7))
+	require.NoError(t, err)
+	require.Len(t, all, 25)
+
+	_, err = api.Contacts().List(ctx, upapi.ContactListOptions{Page: 4, PageSize: 10})
+	require.True(t, upapi.IsNotFound(err))
+}
+
+func TestStatusPages(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	sp, err := api.StatusPages().Create(ctx, upapi.StatusPage{Name: "public", AuthPassword: "secret"})
+	require.NoError(t, err)
+	require.Empty(t, sp.AuthPassword)
+
+	comp, err := api.StatusPages().Components(sp).Create(ctx, upapi.StatusPageComponent{Name: "API"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), comp.PK)
+
+	inc, err := api.StatusPages().Incidents(sp).Create(ctx, upapi.StatusPageIncident{Name: "Outage"})
+	require.NoError(t, err)
+	require.NotEmpty(t, inc.StartsAt)
+
+	_, err = api.StatusPages().Components(upapi.PrimaryKey(42)).List(ctx, upapi.StatusPageComponentListOptions{})
+	require.True(t, upapi.IsNotFound(err))
+
+	require.NoError(t, api.StatusPages().Delete(ctx, sp))
+	require.Equal(t, 0, fake.Len("statuspages/1/components"))
+}
+
+func TestUsersAndSubaccounts(t *testing.T) {
+	ctx := context.Background()
+	_, api := newTestAPI(t)
+
+	u, err := api.Users().Create(ctx, upapi.UserCreateRequest{Email: "jane@example.com", Password: "hunter2"})
+	require.NoError(t, err)
+	require.True(t, u.IsActive)
+	require.Empty(t, u.Password)
+
+	u, err = api.Users().Deactivate(ctx, u)
+	require.NoError(t, err)
+	require.False(t, u.IsActive)
+
+	sub, err := api.Subaccounts().Create(ctx, upapi.SubaccountCreateRequest{Name: "team"})
+	require.NoError(t, err)
+	subs, err := api.Subaccounts().List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []upapi.Subaccount{*sub}, subs)
+	require.NoError(t, api.Subaccounts().TransferPacks(ctx, sub, upapi.SubaccountPacks{Num: 2}))
+}
+
+func TestIntegrationsAndCredentials(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	slack, err := api.Integrations().CreateSlack(ctx, upapi.IntegrationSlack{Name: "ops", WebhookURL: "https://hooks"})
+	require.NoError(t, err)
+	require.Equal(t, "slack", slack.Module)
+	list, err := api.Integrations().List(ctx, upapi.IntegrationListOptions{Module: "slack"})
+	require.NoError(t, err)
+	require.Equal(t, []upapi.Integration{*slack}, list.Items)
+
+	cred, err := api.Credentials().Create(ctx, upapi.Credential{
+		DisplayName:    "login",
+		CredentialType: "BASIC",
+		Secret:         upapi.CredentialSecret{Password: "hunter2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cred.PK)
+	require.Empty(t, cred.Secret.Password)
+
+	var items []map[string]any
+	require.True(t, fake.List("credentials", &items))
+	require.Equal(t, float64(1), items[0]["id"])
+	require.NotContains(t, items[0], "pk")
+}
+
+func TestPausedChecks(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	fake.Add("checks", upapi.Check{Name: "web", Address: "https://example.com"})
+	fake.Add("checks", upapi.Check{Name: "old", Address: "https://old.example.com", IsPaused: true})
+
+	paused, err := api.Checks().List(ctx, upapi.CheckListOptions{IsPaused: true})
+	require.NoError(t, err)
+	require.Len(t, paused.Items, 1)
+	require.Equal(t, "old", paused.Items[0].Name)
+	active, err := api.Checks().List(ctx, upapi.CheckListOptions{})
+	require.NoError(t, err)
+	require.Len(t, active.Items, 1)
+	require.Equal(t, "web", active.Items[0].Name)
+}
+
+func TestOutagesAndAlerts(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+
+	fake.Add("outages", upapi.Outage{CheckPK: 1, CheckName: "web"})
+	fake.Add("outages", upapi.Outage{CheckPK: 2, CheckName: "api"})
+	pk := fake.Add("alerts", upapi.AlertItem{CheckPK: 1, Output: "timeout"})
+
+	outages, err := api.Outages().List(ctx, upapi.OutageListOptions{Search: "api"})
+	require.NoError(t, err)
+	require.Len(t, outages.Items, 1)
+	require.Equal(t, int64(2), outages.Items[0].CheckPK)
+
+	alert, err := api.Alerts().Ignore(ctx, upapi.PrimaryKey(pk))
+	require.NoError(t, err)
+	require.True(t, alert.Ignored)
+
+	rc, err := api.Alerts().RootCause(ctx, upapi.PrimaryKey(pk))
+	require.NoError(t, err)
+	require.Equal(t, "timeout", rc.Output)
+}
+
+func TestFailureInjection(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t, upapi.WithRetryPolicy(&upapi.ExponentialBackoff{InitialInterval: time.Millisecond}))
+
+	fake.Throttle(2, 0)
+	_, err := api.Tags().Create(ctx, upapi.Tag{Tag: "prod"})
+	require.NoError(t, err)
+	require.Len(t, fake.Requests(), 3)
+
+	fake.Fail(1, http.StatusServiceUnavailable)
+	fake.Throttle(1, 0)
+	_, err = api.Tags().List(ctx, upapi.TagListOptions{})
+	require.NoError(t, err)
+	require.Len(t, fake.Requests(), 6)
+}
+
+func TestRequireToken(t *testing.T) {
+	ctx := context.Background()
+	fake, api := newTestAPI(t)
+	fake.RequireToken("other")
+
+	_, err := api.Tags().List(ctx, upapi.TagListOptions{})
+	require.True(t, upapi.IsAuth(err))
+}